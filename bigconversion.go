@@ -0,0 +1,144 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package ints
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// BigConvert provides the [BigDecimal]-value creating conversion
+// functions of a [BigContext]'s From-property.
+type BigConvert struct {
+	cntx *BigContext
+}
+
+// Str converts given string to a [BigDecimal]-value.  The string is
+// interpreted as [UConvert.Str] does, i.e. superfluous fractionals are
+// rounded according to the context's rounding mode or truncated, but it
+// never overflows.  Str fails if the string has other characters than
+// digits and one decimal separator while empty integrals and
+// fractionals are zero.
+func (c BigConvert) Str(s string) (BigDecimal, error) {
+	ii, ff := s, ""
+	sep := c.cntx.cntx.separator
+	if i := strings.IndexRune(s, sep); i >= 0 {
+		ii, ff = s[:i], s[i+len(string(sep)):]
+	}
+	n, dropped := c.cntx.cntx.flags.Fractionals(), ""
+	if len(ff) > n {
+		ff, dropped = ff[:n], ff[n:]
+	}
+	digits := ii + ff + strings.Repeat("0", n-len(ff))
+	if strings.Trim(digits+dropped, "0123456789") != "" {
+		return BigDecimal{}, fmt.Errorf(
+			"ints: dec: big: parse %q: %w", s, strconv.ErrSyntax)
+	}
+	if digits == "" {
+		digits = "0"
+	}
+	v, ok := new(big.Int).SetString(digits, 10)
+	if !ok {
+		return BigDecimal{}, fmt.Errorf(
+			"ints: dec: big: parse %q: %w", s, strconv.ErrSyntax)
+	}
+	if !isZeros(dropped) && roundUp(c.cntx.cntx.flags.Rounding(DOWN),
+		halfOfDigits(dropped), v.Bit(0) == 1, false) {
+		v.Add(v, big.NewInt(1))
+	}
+	return c.cntx.norm(v), nil
+}
+
+// MStr is the "must"-variant of [BigConvert.Str] which panics if
+// corresponding Str-call fails.
+func (c BigConvert) MStr(s string) BigDecimal {
+	v, err := c.Str(s)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float converts given float's shortest decimal representation to a
+// [BigDecimal]-value; see [BigConvert.Str].  It fails with an
+// [ErrOverflow] if given float is negative, infinite or not a number.
+func (c BigConvert) Float(f float64) (BigDecimal, error) {
+	if f < 0 || math.IsInf(f, 0) || math.IsNaN(f) {
+		return BigDecimal{}, ErrOverflow
+	}
+	return c.Str(strings.Replace(strconv.FormatFloat(f, 'f', -1, 64),
+		".", string(c.cntx.cntx.separator), 1))
+}
+
+// MFloat is the "Must"-variant of [BigConvert.Float] which panics if
+// corresponding Float-call fails.
+func (c BigConvert) MFloat(f float64) BigDecimal {
+	v, err := c.Float(f)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints converts given integrals, fractionals and leading zeros lz of
+// the fractionals to a [BigDecimal]-value; see [UConvert.Ints].  It
+// fails only if lz is positive and not smaller than the context's
+// fractionals.
+func (c BigConvert) Ints(
+	integrals, fractionals uint64, lz int,
+) (BigDecimal, error) {
+	if integrals == 0 && fractionals == 0 {
+		return BigDecimal{}, nil
+	}
+	n := c.cntx.cntx.flags.Fractionals()
+	if lz > 0 && lz >= n {
+		return BigDecimal{}, ErrOverflow
+	}
+	ff := ""
+	if fractionals > 0 {
+		ff = strings.Repeat("0", lz) + strconv.FormatUint(fractionals, 10)
+	}
+	return c.Str(strconv.FormatUint(integrals, 10) +
+		string(c.cntx.cntx.separator) + ff)
+}
+
+// MInts is the "Must"-variant of [BigConvert.Ints] which panics if
+// corresponding Ints-call fails.
+func (c BigConvert) MInts(integrals, fractionals uint64, lz int) BigDecimal {
+	v, err := c.Ints(integrals, fractionals, lz)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// UDecimal returns given decimal of the associated context as
+// [BigDecimal].
+func (c BigConvert) UDecimal(d UDecimal) BigDecimal {
+	return BigDecimal{u: d}
+}
+
+// Big converts given [BigDecimal] to a [UDecimal]-value of the same
+// context.  It fails with an [ErrOverflow] if given decimal was
+// promoted, i.e. exceeds the context's Max-property.
+func (c UConvert) Big(d BigDecimal) (UDecimal, error) {
+	if d.b != nil {
+		return 0, ErrOverflow
+	}
+	return d.u, nil
+}
+
+// MBig is the "Must"-variant of [UConvert.Big] which panics if
+// corresponding Big-call fails.
+func (c UConvert) MBig(d BigDecimal) UDecimal {
+	v, err := c.Big(d)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
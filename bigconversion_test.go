@@ -0,0 +1,70 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package ints
+
+import (
+	"math"
+	"testing"
+
+	. "github.com/slukits/gounit"
+)
+
+type bigConversion struct{ Suite }
+
+func (s *bigConversion) SetUp(t *T) { t.Parallel() }
+
+func (s *bigConversion) Parses_strings_of_any_size(t *T) {
+	dec := UDec.New(FOUR_FRACTIONALS, DOT_SEPARATOR)
+	t.Eq(UDecimal(12345), dec.From.MBig(dec.Big.From.MStr("1.23456")))
+	for _, z := range []string{"", ".", "0.", ".0", "0.0"} {
+		t.Eq(UDecimal(0), dec.From.MBig(dec.Big.From.MStr(z)))
+	}
+	d := dec.Big.From.MStr("123456789012345678901234567890.12345")
+	t.True(d.IsBig())
+	t.Eq("123456789012345678901234567890.12", d.Str(dec))
+	for _, s := range []string{"abc", "4.a", "-4", "4.2.1"} {
+		_, err := dec.Big.From.Str(s)
+		t.ErrMatched(err, "invalid syntax")
+	}
+	t.Panics(func() { dec.Big.From.MStr("x") })
+}
+
+func (s *bigConversion) Parses_empty_strings_without_fractionals(t *T) {
+	dec := UDec.New(ZERO_FRACTIONALS, DOT_SEPARATOR)
+	for _, z := range []string{"", "."} {
+		v, err := dec.Big.From.Str(z)
+		t.FatalOn(err)
+		t.Eq(UDecimal(0), dec.From.MBig(v))
+	}
+}
+
+func (s *bigConversion) Converts_floats(t *T) {
+	t.Eq(UDec.From.MFloat(42.42), UDec.From.MBig(UDec.Big.From.MFloat(
+		42.42)))
+	dec := UDec.New(COMMA_SEPARATOR, DOT_SEPARATOR)
+	t.Eq("1000000000000000000000.00", dec.Big.From.MFloat(1e21).Str(dec))
+	for _, f := range []float64{-1, math.Inf(1), math.NaN()} {
+		_, err := UDec.Big.From.Float(f)
+		t.ErrIs(err, ErrOverflow)
+	}
+	t.Panics(func() { UDec.Big.From.MFloat(-1) })
+}
+
+func (s *bigConversion) Converts_ints(t *T) {
+	dec := UDec.New(FOUR_FRACTIONALS, DEFAULTS)
+	t.Eq(UDecimal(12345), dec.From.MBig(dec.Big.From.MInts(1, 23456, 0)))
+	t.Eq(UDecimal(40002), dec.From.MBig(dec.Big.From.MInts(4, 2, 3)))
+	t.Eq(UDecimal(40000), dec.From.MBig(dec.Big.From.MInts(4, 0, 0)))
+	t.Eq(UDecimal(0), dec.From.MBig(dec.Big.From.MInts(0, 0, 0)))
+	t.True(UDec.Big.From.MInts(math.MaxUint64, 0, 0).IsBig())
+	_, err := dec.Big.From.Ints(4, 2, 4)
+	t.ErrIs(err, ErrOverflow)
+	t.Panics(func() { dec.Big.From.MInts(4, 2, 4) })
+}
+
+func TestBigConversion(t *testing.T) {
+	t.Parallel()
+	Run(&bigConversion{}, t)
+}
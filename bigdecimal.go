@@ -0,0 +1,237 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package ints
+
+import (
+	"math/big"
+	"strings"
+)
+
+// BigDecimal value represents together with a [UContext]-instance a
+// non-negative decimal of arbitrary precision whose last n positions
+// are interpreted as the decimal's fractionals whereas n is defined by
+// the context's arithmetic flags.  A BigDecimal is an [UDecimal] as long
+// as it doesn't exceed the context's Max-property; it is promoted to a
+// [big.Int] based value only if an operation overflows.  The zero value
+// is the decimal zero.  BigDecimal values are immutable.
+type BigDecimal struct {
+	u UDecimal
+	b *big.Int
+}
+
+// IsBig returns true if given decimal was promoted to arbitrary
+// precision, i.e. it exceeds the Max-property of its context.
+func (d BigDecimal) IsBig() bool { return d.b != nil }
+
+// Int returns given decimal's underlying integer.
+func (d BigDecimal) Int() *big.Int {
+	if d.b != nil {
+		return new(big.Int).Set(d.b)
+	}
+	return new(big.Int).SetUint64(uint64(d.u))
+}
+
+// Cmp compares given decimals d and e and returns -1 if d < e, 0 if
+// d == e and +1 if d > e.
+func (d BigDecimal) Cmp(e BigDecimal) int {
+	if d.b == nil && e.b == nil {
+		switch {
+		case d.u < e.u:
+			return -1
+		case d.u > e.u:
+			return 1
+		}
+		return 0
+	}
+	return d.Int().Cmp(e.Int())
+}
+
+// Str returns a string representation of given value relative to the
+// settings of given context truncating or padding its fractionals as
+// [UDecimal.Str] does.
+func (d BigDecimal) Str(c *UContext) string {
+	cf, sf := c.flags.Fractionals(), c.flags.FmtFractionals()
+	if sf >= cf {
+		return bigFormat(d.Int(), cf, sf-cf, c.fmtSeparator,
+			c.grouping)
+	}
+	v := d.Int()
+	v.Quo(v, bigPow10(cf-sf))
+	return bigFormat(v, sf, 0, c.fmtSeparator, c.grouping)
+}
+
+// Rnd returns a rounded to even string representation of given value
+// iff the ..._FRACTIONALS of given context's format flags is smaller
+// than its corresponding arithmetic flag; see [UDecimal.Rnd].
+func (d BigDecimal) Rnd(c *UContext) string {
+	cf, sf := c.flags.Fractionals(), c.flags.FmtFractionals()
+	if sf >= cf {
+		return d.Str(c)
+	}
+	return bigFormat(bigRnd(d.Int(), cf-sf), sf, 0, c.fmtSeparator,
+		c.grouping)
+}
+
+// bigRnd returns given integer divided by 10^n rounded to even.
+func bigRnd(v *big.Int, n int) *big.Int {
+	pow := bigPow10(n)
+	q, r := new(big.Int).QuoRem(v, pow, new(big.Int))
+	switch r.Lsh(r, 1).Cmp(pow) {
+	case 1:
+		q.Add(q, big.NewInt(1))
+	case 0:
+		if q.Bit(0) == 1 {
+			q.Add(q, big.NewInt(1))
+		}
+	}
+	return q
+}
+
+// bigFormat returns given integer's digits with given number of
+// fractionals separated by given separator and padded with given
+// number of zeros; see [format] for the grouping separator.
+func bigFormat(
+	v *big.Int, fractionals, pad int, sep, grouping rune,
+) string {
+	s := v.String()
+	if fractionals+pad == 0 {
+		return group(s, grouping)
+	}
+	if len(s) <= fractionals {
+		s = strings.Repeat("0", fractionals-len(s)+1) + s
+	}
+	return group(s[:len(s)-fractionals], grouping) + string(sep) +
+		s[len(s)-fractionals:] + strings.Repeat("0", pad)
+}
+
+func bigPow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+// BigContext provides the arithmetic of [BigDecimal]-values of a
+// [UContext]'s Big-property.  It is the promoting mode of its context:
+// as long as the operands and the result of an operation fit into an
+// [UDecimal] the context's uint64-based arithmetic is used; otherwise
+// the result is promoted to arbitrary precision instead of failing with
+// an [ErrOverflow].
+type BigContext struct {
+	cntx *UContext
+
+	// From provides conversion methods returning BigDecimal-values.
+	From *BigConvert
+}
+
+// Add adds given decimals and returns their sum.
+func (c *BigContext) Add(a, b BigDecimal) (BigDecimal, error) {
+	if a.b == nil && b.b == nil {
+		if sum, err := c.cntx.add(a.u, b.u); err == nil {
+			return BigDecimal{u: sum}, nil
+		}
+	}
+	return c.norm(new(big.Int).Add(a.Int(), b.Int())), nil
+}
+
+// MAdd is the 'Must'-version of [BigContext.Add] which panics if
+// corresponding Add-call fails.
+func (c *BigContext) MAdd(a, b BigDecimal) BigDecimal {
+	sum, err := c.Add(a, b)
+	if err != nil {
+		panic(err)
+	}
+	return sum
+}
+
+// Sub subtracts given decimal b from a and returns their difference.
+// Sub fails with an overflow error if b is greater than a.
+func (c *BigContext) Sub(a, b BigDecimal) (BigDecimal, error) {
+	if b.Cmp(a) > 0 {
+		return BigDecimal{}, ErrOverflow
+	}
+	if a.b == nil {
+		return BigDecimal{u: a.u - b.u}, nil
+	}
+	return c.norm(new(big.Int).Sub(a.Int(), b.Int())), nil
+}
+
+// MSub is the 'Must'-version of [BigContext.Sub] which panics if
+// corresponding Sub-call fails.
+func (c *BigContext) MSub(a, b BigDecimal) BigDecimal {
+	diff, err := c.Sub(a, b)
+	if err != nil {
+		panic(err)
+	}
+	return diff
+}
+
+// Mult multiplies given decimals and returns their product whose
+// superfluous fractionals are rounded according to the context's
+// rounding mode; without a rounding mode they are truncated.
+func (c *BigContext) Mult(a, b BigDecimal) (BigDecimal, error) {
+	if a.b == nil && b.b == nil {
+		if prd, err := c.cntx.mult(a.u, b.u, false); err == nil {
+			return BigDecimal{u: prd}, nil
+		}
+	}
+	return c.quo(new(big.Int).Mul(a.Int(), b.Int()), c.pow()), nil
+}
+
+// MMult is the 'Must'-variant of [BigContext.Mult] which panics if
+// corresponding Mult-call fails.
+func (c *BigContext) MMult(a, b BigDecimal) BigDecimal {
+	prd, err := c.Mult(a, b)
+	if err != nil {
+		panic(err)
+	}
+	return prd
+}
+
+// Div divides a by b and returns resulting quotient which is rounded
+// according to the context's rounding mode; without a rounding mode it
+// is truncated.  Div fails if b is zero.
+func (c *BigContext) Div(a, b BigDecimal) (BigDecimal, error) {
+	if b.b == nil && b.u == 0 {
+		return BigDecimal{}, ErrDividedByZero
+	}
+	if a.b == nil && b.b == nil {
+		if qut, err := c.cntx.div(a.u, b.u, false); err == nil {
+			return BigDecimal{u: qut}, nil
+		}
+	}
+	return c.quo(new(big.Int).Mul(a.Int(), c.pow()), b.Int()), nil
+}
+
+// MDiv is the 'Must'-variant of [BigContext.Div] which panics if
+// corresponding Div-call fails.
+func (c *BigContext) MDiv(a, b BigDecimal) BigDecimal {
+	qut, err := c.Div(a, b)
+	if err != nil {
+		panic(err)
+	}
+	return qut
+}
+
+// quo returns the quotient of given integers rounded according to the
+// context's rounding mode.
+func (c *BigContext) quo(n, d *big.Int) BigDecimal {
+	q, r := new(big.Int).QuoRem(n, d, new(big.Int))
+	if r.Sign() != 0 && roundUp(c.cntx.flags.Rounding(DOWN),
+		r.Lsh(r, 1).Cmp(d), q.Bit(0) == 1, false) {
+		q.Add(q, big.NewInt(1))
+	}
+	return c.norm(q)
+}
+
+func (c *BigContext) pow() *big.Int {
+	return new(big.Int).SetUint64(uint64(c.cntx.pow))
+}
+
+// norm returns given non-negative integer as BigDecimal which is only
+// promoted if it exceeds the context's Max-property.
+func (c *BigContext) norm(v *big.Int) BigDecimal {
+	if v.IsUint64() && UDecimal(v.Uint64()) <= c.cntx.Max {
+		return BigDecimal{u: UDecimal(v.Uint64())}
+	}
+	return BigDecimal{b: v}
+}
@@ -0,0 +1,86 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package ints
+
+import (
+	"testing"
+
+	. "github.com/slukits/gounit"
+)
+
+type bigDecimal struct{ Suite }
+
+func (s *bigDecimal) SetUp(t *T) { t.Parallel() }
+
+func (s *bigDecimal) Is_promoted_only_on_overflow(t *T) {
+	max := UDec.Big.From.UDecimal(UDec.Max)
+	one := UDec.Big.From.MStr("1")
+	t.Not.True(UDec.Big.MAdd(max, BigDecimal{}).IsBig())
+	sum := UDec.Big.MAdd(max, one)
+	t.True(sum.IsBig())
+	t.Eq("18446744073709,99", sum.Str(UDec))
+	t.Not.True(UDec.Big.MSub(sum, one).IsBig())
+	t.Eq(UDec.Max, UDec.From.MBig(UDec.Big.MSub(sum, one)))
+	_, err := UDec.From.Big(sum)
+	t.ErrIs(err, ErrOverflow)
+	t.Panics(func() { UDec.From.MBig(sum) })
+}
+
+func (s *bigDecimal) Sums_beyond_max(t *T) {
+	total, d := BigDecimal{}, UDec.Big.From.MStr("1000000000000.5")
+	for i := 0; i < 100; i++ {
+		total = UDec.Big.MAdd(total, d)
+	}
+	t.Eq("100000000000050,00", total.Str(UDec))
+	t.Eq(1, total.Cmp(UDec.Big.From.UDecimal(UDec.Max)))
+	t.Eq(-1, d.Cmp(total))
+	t.Eq(0, total.Cmp(UDec.Big.MMult(d, UDec.Big.From.MStr("100"))))
+}
+
+func (s *bigDecimal) Subtraction_fails_if_negative(t *T) {
+	a, b := UDec.Big.From.MStr("1"), UDec.Big.From.MStr("2")
+	_, err := UDec.Big.Sub(a, b)
+	t.ErrIs(err, ErrOverflow)
+	t.Panics(func() { UDec.Big.MSub(a, b) })
+}
+
+func (s *bigDecimal) Multiplies_and_divides_truncating(t *T) {
+	t.Eq(UDecimal(1523990), UDec.From.MBig(UDec.Big.MMult(
+		UDec.Big.From.MFloat(1.2345), UDec.Big.From.MFloat(1.2345))))
+	big := UDec.Big.From.MStr("100000000000000000000.123456")
+	t.Eq("10000000000000000000012345600000000,00", UDec.Big.MMult(
+		big, UDec.Big.From.MStr("100000000000000")).Str(UDec))
+	t.Eq("10000000000000000000024691200000000000000,01", UDec.Big.MMult(
+		big, big).Str(UDec))
+	t.Eq("33333333333333333333,37", UDec.Big.MDiv(big,
+		UDec.Big.From.MStr("3")).Str(UDec))
+	t.Eq(UDecimal(526282), UDec.From.MBig(UDec.Big.MDiv(
+		UDec.Big.From.MFloat(1.2345), UDec.Big.From.MFloat(2.3457))))
+	_, err := UDec.Big.Div(big, BigDecimal{})
+	t.ErrIs(err, ErrDividedByZero)
+	t.Panics(func() { UDec.Big.MDiv(big, BigDecimal{}) })
+}
+
+func (s *bigDecimal) String_truncates_and_rounds_as_udecimal(t *T) {
+	dec := UDec.New(DEFAULTS, DOT_SEPARATOR|TWO_FRACTIONALS)
+	for _, f := range []string{"3.025", "3.035", "3.0351", "0.004",
+		"3.2", "0.1"} {
+		d := dec.From.MStr(f)
+		t.Eq(d.Str(dec), dec.Big.From.UDecimal(d).Str(dec))
+		t.Eq(d.Rnd(dec), dec.Big.From.UDecimal(d).Rnd(dec))
+	}
+	dec.SetFmt(EIGHT_FRACTIONALS)
+	t.Eq("3.20000000", dec.Big.From.MStr("3.2").Str(dec))
+	t.Eq("3.20000000", dec.Big.From.MStr("3.2").Rnd(dec))
+	t.Eq("99999999999999999999.99", UDec.Big.From.MStr(
+		"99999999999999999999.994").Rnd(dec.New(DEFAULTS, TWO_FRACTIONALS)))
+	t.Eq("100000000000000000000.00", UDec.Big.From.MStr(
+		"99999999999999999999.995").Rnd(dec.New(DEFAULTS, TWO_FRACTIONALS)))
+}
+
+func TestBigDecimal(t *testing.T) {
+	t.Parallel()
+	Run(&bigDecimal{}, t)
+}
@@ -0,0 +1,189 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package ints
+
+import (
+	"math/bits"
+	"strconv"
+	"strings"
+)
+
+// cowBlockWords is the number of words a COWSet copies at once.
+const cowBlockWords = 8
+
+const cowBlockBits = cowBlockWords * 64
+
+// cowBlock is a copy on write unit of words which is owned by the
+// COWSet's epoch it was created in.  A block referenced by a SetView
+// is never modified.
+type cowBlock struct {
+	epoch uint64
+	words [cowBlockWords]uint64
+}
+
+// COWSet is a copy on write set of non-negative integers which
+// provides immutable snapshots of itself in constant time.  After a
+// snapshot was taken a write copies only the block of words it touches
+// (and once the slice referencing the blocks).  Hence many goroutines
+// may iterate the [SetView]s of a COWSet without holding a lock while a
+// single writer keeps mutating it.  A COWSet itself is not safe for
+// concurrent use, i.e. Snapshot must be called by the writer.  The zero
+// value is ready to use.
+type COWSet struct {
+	blocks      []*cowBlock
+	cardinality int
+	epoch       uint64
+	// shared is true if blocks is referenced by a view.
+	shared bool
+}
+
+// SetView is an immutable view of a [COWSet] as it was when
+// [COWSet.Snapshot] was called.  A SetView is safe for concurrent use.
+type SetView struct {
+	blocks      []*cowBlock
+	cardinality int
+}
+
+// Snapshot returns an immutable view of receiving set's current
+// elements in constant time.
+func (s *COWSet) Snapshot() *SetView {
+	v := &SetView{blocks: s.blocks, cardinality: s.cardinality}
+	s.epoch++
+	s.shared = true
+	return v
+}
+
+// Len returns the set's cardinality.
+func (s *COWSet) Len() int { return s.cardinality }
+
+// IsEmpty returns true if the set's cardinality is zero.
+func (s *COWSet) IsEmpty() bool { return s.cardinality == 0 }
+
+// Has returns true if given integers are in receiving set; false
+// otherwise.
+func (s *COWSet) Has(elm int, elms ...int) bool {
+	return cowHas(s.blocks, elm, elms)
+}
+
+// For calls back for each element e providing e in ascending order.
+func (s *COWSet) For(elm func(int)) { cowFor(s.blocks, elm) }
+
+// String returns a set's string representation {e1, e2, e3, ..., eN}.
+func (s *COWSet) String() string { return cowString(s.blocks) }
+
+// Add adds given integers to receiving set whereas negative integers
+// are ignored.
+func (s *COWSet) Add(elms ...int) *COWSet {
+	for _, elm := range elms {
+		if elm < 0 || cowHasOne(s.blocks, elm) {
+			continue
+		}
+		b := s.writable(elm / cowBlockBits)
+		b.words[elm%cowBlockBits/64] |= 1 << (elm % 64)
+		s.cardinality++
+	}
+	return s
+}
+
+// Del removes given elements from receiving set.
+func (s *COWSet) Del(elm int, elms ...int) *COWSet {
+	for _, elm := range append([]int{elm}, elms...) {
+		if !cowHasOne(s.blocks, elm) {
+			continue
+		}
+		b := s.writable(elm / cowBlockBits)
+		b.words[elm%cowBlockBits/64] &^= 1 << (elm % 64)
+		s.cardinality--
+	}
+	return s
+}
+
+// writable returns the block with given index which is owned by the
+// set's current epoch, i.e. which may be modified.  The set's blocks
+// are extended if necessary.
+func (s *COWSet) writable(idx int) *cowBlock {
+	if s.shared {
+		s.blocks = append([]*cowBlock(nil), s.blocks...)
+		s.shared = false
+	}
+	for idx >= len(s.blocks) {
+		s.blocks = append(s.blocks, nil)
+	}
+	b := s.blocks[idx]
+	switch {
+	case b == nil:
+		b = &cowBlock{epoch: s.epoch}
+	case b.epoch != s.epoch:
+		b = &cowBlock{epoch: s.epoch, words: b.words}
+	default:
+		return b
+	}
+	s.blocks[idx] = b
+	return b
+}
+
+// Len returns the view's cardinality.
+func (v *SetView) Len() int { return v.cardinality }
+
+// IsEmpty returns true if the view's cardinality is zero.
+func (v *SetView) IsEmpty() bool { return v.cardinality == 0 }
+
+// Has returns true if given integers are in receiving view; false
+// otherwise.
+func (v *SetView) Has(elm int, elms ...int) bool {
+	return cowHas(v.blocks, elm, elms)
+}
+
+// For calls back for each element e providing e in ascending order.
+func (v *SetView) For(elm func(int)) { cowFor(v.blocks, elm) }
+
+// ToSet returns a [Set] with the elements of receiving view.
+func (v *SetView) ToSet() *Set {
+	s := &Set{}
+	v.For(s.add)
+	return s
+}
+
+// String returns a view's string representation {e1, e2, e3, ..., eN}.
+func (v *SetView) String() string { return cowString(v.blocks) }
+
+func cowHas(bb []*cowBlock, elm int, elms []int) bool {
+	if !cowHasOne(bb, elm) {
+		return false
+	}
+	for _, elm := range elms {
+		if !cowHasOne(bb, elm) {
+			return false
+		}
+	}
+	return true
+}
+
+func cowHasOne(bb []*cowBlock, elm int) bool {
+	if elm < 0 || elm/cowBlockBits >= len(bb) {
+		return false
+	}
+	b := bb[elm/cowBlockBits]
+	return b != nil && b.words[elm%cowBlockBits/64]&(1<<(elm%64)) != 0
+}
+
+func cowFor(bb []*cowBlock, elm func(int)) {
+	for i, b := range bb {
+		if b == nil {
+			continue
+		}
+		for j, w := range b.words {
+			for ; w != 0; w &= w - 1 {
+				elm(i*cowBlockBits + j*64 + bits.TrailingZeros64(w))
+			}
+		}
+	}
+}
+
+func cowString(bb []*cowBlock) string {
+	var elms []string
+	cowFor(bb, func(elm int) { elms = append(elms, strconv.Itoa(elm)) })
+	return "{" + strings.Join(elms, ", ") + "}"
+}
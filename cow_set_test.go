@@ -0,0 +1,88 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package ints
+
+import (
+	"sync"
+	"testing"
+
+	. "github.com/slukits/gounit"
+)
+
+type cowSet struct{ Suite }
+
+func (s *cowSet) SetUp(t *T) { t.Parallel() }
+
+func (s *cowSet) Is_initially_empty(t *T) {
+	var fx COWSet
+	t.True(fx.IsEmpty())
+	t.True(fx.Snapshot().IsEmpty())
+	t.Not.True(fx.Has(0))
+}
+
+func (s *cowSet) Has_added_and_not_deleted_elements(t *T) {
+	fx := (&COWSet{}).Add(1, 512, 513, 1, -1)
+	t.True(fx.Has(1, 512, 513))
+	t.Eq(3, fx.Len())
+	fx.Del(512, 7)
+	t.Not.True(fx.Has(512))
+	t.Eq("{1, 513}", fx.String())
+}
+
+func (s *cowSet) Snapshot_is_unaffected_by_later_writes(t *T) {
+	fx := (&COWSet{}).Add(1, 2, 600)
+	v1 := fx.Snapshot()
+	fx.Add(3, 5000).Del(1)
+	v2 := fx.Snapshot()
+	fx.Del(600)
+	t.Eq("{1, 2, 600}", v1.String())
+	t.Eq(3, v1.Len())
+	t.Eq("{2, 3, 600, 5000}", v2.String())
+	t.Eq("{2, 3, 5000}", fx.String())
+	t.True(v1.ToSet().Eq(FromSlice([]int{1, 2, 600})))
+}
+
+func (s *cowSet) Writes_copy_only_touched_blocks(t *T) {
+	fx := (&COWSet{}).Add(0, cowBlockBits, 2*cowBlockBits)
+	v := fx.Snapshot()
+	fx.Add(cowBlockBits + 1)
+	t.True(v.blocks[0] == fx.blocks[0])
+	t.True(v.blocks[1] != fx.blocks[1])
+	t.True(v.blocks[2] == fx.blocks[2])
+}
+
+func (s *cowSet) Views_are_readable_while_writer_mutates(t *T) {
+	fx, views := &COWSet{}, make(chan *SetView)
+	wg := sync.WaitGroup{}
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for v := range views {
+				n := 0
+				v.For(func(int) { n++ })
+				if n != v.Len() {
+					panic("inconsistent view")
+				}
+			}
+		}()
+	}
+	for i := 0; i < 2000; i++ {
+		fx.Add(i * 7)
+		if i%3 == 0 {
+			fx.Del(i * 5)
+		}
+		if i%10 == 0 {
+			views <- fx.Snapshot()
+		}
+	}
+	close(views)
+	wg.Wait()
+}
+
+func TestCOWSet(t *testing.T) {
+	t.Parallel()
+	Run(&cowSet{}, t)
+}
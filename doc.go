@@ -62,7 +62,25 @@
 //	d := dec.From.MStr("2,5")
 //
 // The second argument sets the format flags which in above case are
-// simply a copy of [ints.UDec]'s format flags.
+// simply a copy of [ints.UDec]'s format flags.  A context has between
+// zero and [MaxFractionals] fractionals; [UContext.NewFractionals]
+// creates a context from the number of fractionals
+//
+//	qty := ints.UDec.MNewFractionals(0, ints.DEFAULTS, ints.ZERO_FRACTIONALS)
+//
+// [NewContext] creates a context from validated options which also
+// allow arbitrary separators and grouping
+//
+//	chf := ints.MNewContext(ints.Separator('.'), ints.Grouping('\''))
+//
+// A [LocaleFormatter] formats decimals according to a [Locale] of the
+// embedded table, see [LookupLocale].  [UConvert.Parse] parses
+// grouped, signed, currency annotated or scientific numbers as enabled
+// by given [ParseOptions].
+//
+// The exact conversions like [UConvert.StrExact] fail with an
+// [ErrInexact] carrying the lost fractionals instead of silently
+// truncating them.
 //
 // NOTE while I try to write idiomatic go code I value a convenient to
 // use (practical) API higher than the "make the zero type usable"
@@ -81,4 +99,23 @@
 // While [UDecimal.Str] truncates superfluous fractionals [UDecimal.Rnd]
 // returns a "round to even" string representation of a [ints.UDecimal]
 // value.
+//
+// For values which may become negative [ints.SDecimal] with its
+// associated [ints.SContext] mirrors the above API; [ints.SDec]
+// provides the ready to use default signed context.
+//
+//	balance := ints.SDec.MSub(
+//	    ints.SDec.From.MStr("10.5"),
+//	    ints.SDec.From.MStr("12"),
+//	)
+//	fmt.Print(balance.Str(ints.SDec)) // prints "-1,50"
+//
+// A context's Big-property provides the same arithmetic for
+// [ints.BigDecimal] values which are promoted to arbitrary precision
+// instead of failing with an [ints.ErrOverflow].
+//
+//	total := ints.BigDecimal{}
+//	for _, d := range lines {
+//	    total = ints.UDec.Big.MAdd(total, ints.UDec.Big.From.UDecimal(d))
+//	}
 package ints
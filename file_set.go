@@ -0,0 +1,299 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package ints
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math/bits"
+	"os"
+)
+
+// ErrInvalidFileSet is returned by [OpenFileSet] if the opened file is
+// not a file set of a supported version.
+var ErrInvalidFileSet = errors.New("ints: file set: invalid file")
+
+// FileSetVersion is the version of the file format written by
+// [CreateFileSet].
+const FileSetVersion = 1
+
+// fileSetMagic identifies a file set file.
+var fileSetMagic = [8]byte{'i', 'n', 't', 's', '.', 's', 'e', 't'}
+
+// A file set file starts with a header of fileSetHeader bytes:
+//
+//	0  magic        [8]byte
+//	8  version      uint32
+//	12 reserved     uint32
+//	16 words        uint64
+//	24 cardinality  uint64
+//
+// followed by the set's words as uint64; all little-endian.  Hence
+// opening a file set needs no decoding of its elements.
+const (
+	fileSetHeader       = 32
+	fileSetWordsOff     = 16
+	fileSetCardOff      = 24
+	fileSetInitialWords = 16
+)
+
+// fileBuffer provides the bytes of a file set's file.
+type fileBuffer interface {
+	bytes() []byte
+	// resize changes the file's and the buffer's size.
+	resize(size int) error
+	sync() error
+	close() error
+}
+
+// FileSet is a set of non-negative integers whose words live in a
+// file which is memory mapped where supported; otherwise the file is
+// read into memory at opening and written back at [FileSet.Sync].  A
+// FileSet is not safe for concurrent use.  Create a FileSet with
+// [CreateFileSet] or [OpenFileSet]; the zero value is NOT ready to use.
+type FileSet struct {
+	buf fileBuffer
+}
+
+// CreateFileSet creates a new empty file set at given path.  It fails
+// if the file already exists.
+func CreateFileSet(path string) (*FileSet, error) {
+	return createFileSet(path, newMmapBuffer)
+}
+
+func createFileSet(
+	path string, open func(*os.File) (fileBuffer, error),
+) (*FileSet, error) {
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE|os.O_EXCL, 0644)
+	if err != nil {
+		return nil, err
+	}
+	size := fileSetHeader + 8*fileSetInitialWords
+	if err := f.Truncate(int64(size)); err != nil {
+		f.Close()
+		os.Remove(path)
+		return nil, err
+	}
+	buf, err := open(f)
+	if err != nil {
+		f.Close()
+		os.Remove(path)
+		return nil, err
+	}
+	bb := buf.bytes()
+	copy(bb, fileSetMagic[:])
+	binary.LittleEndian.PutUint32(bb[8:], FileSetVersion)
+	binary.LittleEndian.PutUint64(bb[fileSetWordsOff:], fileSetInitialWords)
+	return &FileSet{buf: buf}, nil
+}
+
+// OpenFileSet opens the existing file set at given path.  It fails with
+// an [ErrInvalidFileSet] error if the file's header is not valid.
+func OpenFileSet(path string) (*FileSet, error) {
+	return openFileSet(path, newMmapBuffer)
+}
+
+func openFileSet(
+	path string, open func(*os.File) (fileBuffer, error),
+) (*FileSet, error) {
+	f, err := os.OpenFile(path, os.O_RDWR, 0)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateFileSet(f); err != nil {
+		f.Close()
+		return nil, err
+	}
+	buf, err := open(f)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	return &FileSet{buf: buf}, nil
+}
+
+func validateFileSet(f *os.File) error {
+	hdr := make([]byte, fileSetHeader)
+	if _, err := io.ReadFull(f, hdr); err != nil {
+		return fmt.Errorf("%w: header: %v", ErrInvalidFileSet, err)
+	}
+	if string(hdr[:8]) != string(fileSetMagic[:]) {
+		return fmt.Errorf("%w: magic number", ErrInvalidFileSet)
+	}
+	if v := binary.LittleEndian.Uint32(hdr[8:]); v != FileSetVersion {
+		return fmt.Errorf("%w: version %d", ErrInvalidFileSet, v)
+	}
+	stat, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	words := binary.LittleEndian.Uint64(hdr[fileSetWordsOff:])
+	if words > uint64(stat.Size()-fileSetHeader)/8 {
+		return fmt.Errorf("%w: truncated", ErrInvalidFileSet)
+	}
+	return nil
+}
+
+func (s *FileSet) words() int {
+	return int(binary.LittleEndian.Uint64(
+		s.buf.bytes()[fileSetWordsOff:]))
+}
+
+func (s *FileSet) word(i int) uint64 {
+	return binary.LittleEndian.Uint64(s.buf.bytes()[fileSetHeader+8*i:])
+}
+
+func (s *FileSet) setWord(i int, w uint64) {
+	binary.LittleEndian.PutUint64(s.buf.bytes()[fileSetHeader+8*i:], w)
+}
+
+func (s *FileSet) addCardinality(d int) {
+	bb := s.buf.bytes()[fileSetCardOff:]
+	binary.LittleEndian.PutUint64(bb,
+		uint64(int64(binary.LittleEndian.Uint64(bb))+int64(d)))
+}
+
+// Len returns the set's cardinality.
+func (s *FileSet) Len() int {
+	return int(binary.LittleEndian.Uint64(
+		s.buf.bytes()[fileSetCardOff:]))
+}
+
+// IsEmpty returns true if the set's cardinality is zero.
+func (s *FileSet) IsEmpty() bool { return s.Len() == 0 }
+
+// Has returns true if given integers are in receiving set; false
+// otherwise.
+func (s *FileSet) Has(elm int, elms ...int) bool {
+	if !s.has(elm) {
+		return false
+	}
+	for _, elm := range elms {
+		if !s.has(elm) {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *FileSet) has(elm int) bool {
+	if elm < 0 || elm/64 >= s.words() {
+		return false
+	}
+	return s.word(elm/64)&(1<<(elm%64)) != 0
+}
+
+// Add adds given integers to receiving set whereas negative integers
+// are ignored.  Other than [Set.Add] it may fail since the file is
+// grown if an element exceeds the file's capacity.
+func (s *FileSet) Add(elms ...int) error {
+	for _, elm := range elms {
+		if elm < 0 || s.has(elm) {
+			continue
+		}
+		if elm/64 >= s.words() {
+			if err := s.grow(elm/64 + 1); err != nil {
+				return err
+			}
+		}
+		s.setWord(elm/64, s.word(elm/64)|1<<(elm%64))
+		s.addCardinality(1)
+	}
+	return nil
+}
+
+// grow resizes the file to have at least given number of words.
+func (s *FileSet) grow(n int) error {
+	if n < 2*s.words() {
+		n = 2 * s.words()
+	}
+	if err := s.buf.resize(fileSetHeader + 8*n); err != nil {
+		return err
+	}
+	binary.LittleEndian.PutUint64(s.buf.bytes()[fileSetWordsOff:],
+		uint64(n))
+	return nil
+}
+
+// Del removes given elements from receiving set.
+func (s *FileSet) Del(elm int, elms ...int) *FileSet {
+	for _, elm := range append([]int{elm}, elms...) {
+		if !s.has(elm) {
+			continue
+		}
+		s.setWord(elm/64, s.word(elm/64)&^(1<<(elm%64)))
+		s.addCardinality(-1)
+	}
+	return s
+}
+
+// For calls back for each element e providing e in ascending order.
+func (s *FileSet) For(elm func(int)) {
+	for i, n := 0, s.words(); i < n; i++ {
+		for w := s.word(i); w != 0; w &= w - 1 {
+			elm(i*64 + bits.TrailingZeros64(w))
+		}
+	}
+}
+
+// ToSet returns a [Set] with the elements of receiving file set.
+func (s *FileSet) ToSet() *Set {
+	st := &Set{}
+	s.For(st.add)
+	return st
+}
+
+// Sync flushes the set's changes to its file.
+func (s *FileSet) Sync() error { return s.buf.sync() }
+
+// Close syncs the set and releases its file.  A closed set may not be
+// used anymore.
+func (s *FileSet) Close() error {
+	if err := s.buf.sync(); err != nil {
+		s.buf.close()
+		return err
+	}
+	return s.buf.close()
+}
+
+// rwBuffer is the fallback fileBuffer holding a file's content in
+// memory which is written back on sync.
+type rwBuffer struct {
+	f  *os.File
+	bb []byte
+}
+
+func newRWBuffer(f *os.File) (fileBuffer, error) {
+	stat, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	b := &rwBuffer{f: f, bb: make([]byte, stat.Size())}
+	if _, err := f.ReadAt(b.bb, 0); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+func (b *rwBuffer) bytes() []byte { return b.bb }
+
+func (b *rwBuffer) resize(size int) error {
+	if err := b.f.Truncate(int64(size)); err != nil {
+		return err
+	}
+	b.bb = append(b.bb, make([]byte, size-len(b.bb))...)
+	return nil
+}
+
+func (b *rwBuffer) sync() error {
+	if _, err := b.f.WriteAt(b.bb, 0); err != nil {
+		return err
+	}
+	return b.f.Sync()
+}
+
+func (b *rwBuffer) close() error { return b.f.Close() }
@@ -0,0 +1,81 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+//go:build linux || darwin || freebsd || openbsd || dragonfly
+
+package ints
+
+import (
+	"os"
+	"syscall"
+	"unsafe"
+)
+
+// mmapBuffer is a fileBuffer whose bytes are the memory mapped file.
+type mmapBuffer struct {
+	f  *os.File
+	bb []byte
+}
+
+func newMmapBuffer(f *os.File) (fileBuffer, error) {
+	b := &mmapBuffer{f: f}
+	if err := b.mmap(); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+func (b *mmapBuffer) mmap() error {
+	stat, err := b.f.Stat()
+	if err != nil {
+		return err
+	}
+	bb, err := syscall.Mmap(int(b.f.Fd()), 0, int(stat.Size()),
+		syscall.PROT_READ|syscall.PROT_WRITE, syscall.MAP_SHARED)
+	if err != nil {
+		return err
+	}
+	b.bb = bb
+	return nil
+}
+
+func (b *mmapBuffer) bytes() []byte { return b.bb }
+
+func (b *mmapBuffer) resize(size int) error {
+	if err := b.sync(); err != nil {
+		return err
+	}
+	if err := syscall.Munmap(b.bb); err != nil {
+		return err
+	}
+	b.bb = nil
+	if err := b.f.Truncate(int64(size)); err != nil {
+		return err
+	}
+	return b.mmap()
+}
+
+func (b *mmapBuffer) sync() error {
+	if len(b.bb) == 0 {
+		return nil
+	}
+	_, _, errno := syscall.Syscall(syscall.SYS_MSYNC,
+		uintptr(unsafe.Pointer(&b.bb[0])), uintptr(len(b.bb)),
+		syscall.MS_SYNC)
+	if errno != 0 {
+		return errno
+	}
+	return nil
+}
+
+func (b *mmapBuffer) close() error {
+	if b.bb != nil {
+		if err := syscall.Munmap(b.bb); err != nil {
+			b.f.Close()
+			return err
+		}
+		b.bb = nil
+	}
+	return b.f.Close()
+}
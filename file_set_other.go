@@ -0,0 +1,15 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+//go:build !(linux || darwin || freebsd || openbsd || dragonfly)
+
+package ints
+
+import "os"
+
+// newMmapBuffer falls back to the read/write buffer on platforms
+// without supported memory mapping.
+func newMmapBuffer(f *os.File) (fileBuffer, error) {
+	return newRWBuffer(f)
+}
@@ -0,0 +1,113 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package ints
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/slukits/gounit"
+)
+
+type fileSet struct{ Suite }
+
+func (s *fileSet) SetUp(t *T) { t.Parallel() }
+
+// buffers are the fileBuffer implementations every test is run with.
+var buffers = map[string]func(*os.File) (fileBuffer, error){
+	"mmap": newMmapBuffer, "read/write": newRWBuffer,
+}
+
+func (s *fileSet) Is_initially_empty(t *T) {
+	for _, buf := range buffers {
+		fs, err := createFileSet(
+			filepath.Join(t.GoT().TempDir(), "set"), buf)
+		t.FatalOn(err)
+		t.True(fs.IsEmpty())
+		t.Not.True(fs.Has(0))
+		t.FatalOn(fs.Close())
+	}
+}
+
+func (s *fileSet) Has_added_and_not_deleted_elements(t *T) {
+	for _, buf := range buffers {
+		fs, err := createFileSet(
+			filepath.Join(t.GoT().TempDir(), "set"), buf)
+		t.FatalOn(err)
+		t.FatalOn(fs.Add(1, 64, 65, 1, -3))
+		t.True(fs.Has(1, 64, 65))
+		t.Eq(3, fs.Len())
+		fs.Del(64, 2)
+		t.Not.True(fs.Has(64))
+		t.Eq(2, fs.Len())
+		t.Eq("{1, 65}", fs.ToSet().String())
+		t.FatalOn(fs.Close())
+	}
+}
+
+func (s *fileSet) Grows_for_elements_beyond_capacity(t *T) {
+	for _, buf := range buffers {
+		fs, err := createFileSet(
+			filepath.Join(t.GoT().TempDir(), "set"), buf)
+		t.FatalOn(err)
+		t.FatalOn(fs.Add(3, 1_000_000))
+		t.True(fs.Has(3, 1_000_000))
+		t.Eq(2, fs.Len())
+		t.FatalOn(fs.Close())
+	}
+}
+
+func (s *fileSet) Persists_elements_across_reopening(t *T) {
+	for _, buf := range buffers {
+		path := filepath.Join(t.GoT().TempDir(), "set")
+		fs, err := createFileSet(path, buf)
+		t.FatalOn(err)
+		t.FatalOn(fs.Add(0, 42, 4711, 100_000))
+		t.FatalOn(fs.Sync())
+		t.FatalOn(fs.Close())
+
+		fs, err = openFileSet(path, buf)
+		t.FatalOn(err)
+		var ee []int
+		fs.For(func(e int) { ee = append(ee, e) })
+		t.Eq([]int{0, 42, 4711, 100_000}, ee)
+		t.Eq(4, fs.Len())
+		t.FatalOn(fs.Close())
+	}
+}
+
+func (s *fileSet) Creation_fails_if_file_exists(t *T) {
+	path := filepath.Join(t.GoT().TempDir(), "set")
+	t.FatalOn(os.WriteFile(path, nil, 0644))
+	_, err := CreateFileSet(path)
+	t.ErrIs(err, os.ErrExist)
+}
+
+func (s *fileSet) Opening_fails_for_invalid_file(t *T) {
+	dir := t.GoT().TempDir()
+	path := filepath.Join(dir, "set")
+	fs, err := CreateFileSet(path)
+	t.FatalOn(err)
+	t.FatalOn(fs.Close())
+	valid, err := os.ReadFile(path)
+	t.FatalOn(err)
+
+	for _, invalid := range [][]byte{
+		[]byte("short"),
+		append([]byte("no.magic"), valid[8:]...),
+		append(append(valid[:8:8], 2, 0, 0, 0), valid[12:]...),
+		valid[:len(valid)-8],
+	} {
+		t.FatalOn(os.WriteFile(path, invalid, 0644))
+		_, err := OpenFileSet(path)
+		t.ErrIs(err, ErrInvalidFileSet)
+	}
+}
+
+func TestFileSet(t *testing.T) {
+	t.Parallel()
+	Run(&fileSet{}, t)
+}
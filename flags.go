@@ -20,12 +20,21 @@ func (ff *Flags) set(flags Flags) {
 			*ff |= s
 		}
 	}
+	for r := range ffRoundingsSet {
+		if flags&r == r {
+			*ff &^= ffRoundings
+			*ff |= r
+		}
+	}
 }
 
 // ..._SEPARATOR defines a context's Separator-property for Decimal
 // conversion or string output;
 // ..._FRACTIONALS defines a context's number of fractional positions
-// for Decimal conversion and decimal arithmetics or string output.
+// for Decimal conversion and decimal arithmetics or string output;
+// the rounding mode flags HALF_EVEN to HALF_AWAY define how superfluous
+// fractionals are rounded by a context's Mult, Div and conversions (see
+// [UContext.New]).
 const (
 	COMMA_SEPARATOR Flags = 1 << iota
 	DOT_SEPARATOR
@@ -38,41 +47,141 @@ const (
 	SEVEN_FRACTIONALS
 	EIGHT_FRACTIONALS
 
+	// HALF_EVEN rounds to the nearest neighbour and ties to the even
+	// neighbour.
+	HALF_EVEN
+	// HALF_UP rounds to the nearest neighbour and ties away from zero as
+	// Java's, ICU's and Python's decimal half-up do, e.g. -1.005 to
+	// -1.01 at two fractionals.
+	HALF_UP
+	// HALF_DOWN rounds to the nearest neighbour and ties towards zero,
+	// e.g. -1.005 to -1.00 at two fractionals.
+	HALF_DOWN
+	// UP rounds away from zero.
+	UP
+	// DOWN rounds towards zero, i.e. truncates.
+	DOWN
+	// CEILING rounds towards positive infinity.
+	CEILING
+	// FLOOR rounds towards negative infinity.
+	FLOOR
+	// HALF_AWAY rounds to the nearest neighbour and ties away from zero,
+	// i.e. it rounds like HALF_UP whose name it spells out.
+	HALF_AWAY
+
+	// ZERO_FRACTIONALS defines a context of integers, i.e. a context
+	// without fractional positions.
+	ZERO_FRACTIONALS
+	NINE_FRACTIONALS
+	TEN_FRACTIONALS
+	ELEVEN_FRACTIONALS
+	TWELVE_FRACTIONALS
+	THIRTEEN_FRACTIONALS
+	FOURTEEN_FRACTIONALS
+	FIFTEEN_FRACTIONALS
+	SIXTEEN_FRACTIONALS
+	SEVENTEEN_FRACTIONALS
+	EIGHTEEN_FRACTIONALS
+
 	// DEFAULTS used at Context.New allows to indicate that the format
 	// flags or arithmetic flags are copied from the used Context
 	// instance.
 	DEFAULTS = 0
 )
 
-const ffFractionals = ONE_FRACTIONAL | TWO_FRACTIONALS |
-	THREE_FRACTIONALS | FOUR_FRACTIONALS | FIVE_FRACTIONALS |
-	SIX_FRACTIONALS | SEVEN_FRACTIONALS | EIGHT_FRACTIONALS
+// MaxFractionals is the maximal number of fractionals of a [UContext].
+const MaxFractionals = 18
+
+const ffFractionals = ZERO_FRACTIONALS | ONE_FRACTIONAL |
+	TWO_FRACTIONALS | THREE_FRACTIONALS | FOUR_FRACTIONALS |
+	FIVE_FRACTIONALS | SIX_FRACTIONALS | SEVEN_FRACTIONALS |
+	EIGHT_FRACTIONALS | NINE_FRACTIONALS | TEN_FRACTIONALS |
+	ELEVEN_FRACTIONALS | TWELVE_FRACTIONALS | THIRTEEN_FRACTIONALS |
+	FOURTEEN_FRACTIONALS | FIFTEEN_FRACTIONALS | SIXTEEN_FRACTIONALS |
+	SEVENTEEN_FRACTIONALS | EIGHTEEN_FRACTIONALS
 
 var ffFractionalsSet = map[Flags]bool{
-	ONE_FRACTIONAL:    true,
-	TWO_FRACTIONALS:   true,
-	THREE_FRACTIONALS: true,
-	FOUR_FRACTIONALS:  true,
-	FIVE_FRACTIONALS:  true,
-	SIX_FRACTIONALS:   true,
-	SEVEN_FRACTIONALS: true,
-	EIGHT_FRACTIONALS: true,
+	ZERO_FRACTIONALS:      true,
+	ONE_FRACTIONAL:        true,
+	TWO_FRACTIONALS:       true,
+	THREE_FRACTIONALS:     true,
+	FOUR_FRACTIONALS:      true,
+	FIVE_FRACTIONALS:      true,
+	SIX_FRACTIONALS:       true,
+	SEVEN_FRACTIONALS:     true,
+	EIGHT_FRACTIONALS:     true,
+	NINE_FRACTIONALS:      true,
+	TEN_FRACTIONALS:       true,
+	ELEVEN_FRACTIONALS:    true,
+	TWELVE_FRACTIONALS:    true,
+	THIRTEEN_FRACTIONALS:  true,
+	FOURTEEN_FRACTIONALS:  true,
+	FIFTEEN_FRACTIONALS:   true,
+	SIXTEEN_FRACTIONALS:   true,
+	SEVENTEEN_FRACTIONALS: true,
+	EIGHTEEN_FRACTIONALS:  true,
 }
 
 var flagsToFractionals = map[Flags]int{
-	0:                 0,
-	ONE_FRACTIONAL:    1,
-	TWO_FRACTIONALS:   2,
-	THREE_FRACTIONALS: 3,
-	FOUR_FRACTIONALS:  4,
-	FIVE_FRACTIONALS:  5,
-	SIX_FRACTIONALS:   6,
-	SEVEN_FRACTIONALS: 7,
-	EIGHT_FRACTIONALS: 8,
+	0:                     0,
+	ZERO_FRACTIONALS:      0,
+	ONE_FRACTIONAL:        1,
+	TWO_FRACTIONALS:       2,
+	THREE_FRACTIONALS:     3,
+	FOUR_FRACTIONALS:      4,
+	FIVE_FRACTIONALS:      5,
+	SIX_FRACTIONALS:       6,
+	SEVEN_FRACTIONALS:     7,
+	EIGHT_FRACTIONALS:     8,
+	NINE_FRACTIONALS:      9,
+	TEN_FRACTIONALS:       10,
+	ELEVEN_FRACTIONALS:    11,
+	TWELVE_FRACTIONALS:    12,
+	THIRTEEN_FRACTIONALS:  13,
+	FOURTEEN_FRACTIONALS:  14,
+	FIFTEEN_FRACTIONALS:   15,
+	SIXTEEN_FRACTIONALS:   16,
+	SEVENTEEN_FRACTIONALS: 17,
+	EIGHTEEN_FRACTIONALS:  18,
+}
+
+// fractionalsToFlags maps a number of fractionals to its
+// ..._FRACTIONALS flag.
+var fractionalsToFlags = func() map[int]Flags {
+	ff := map[int]Flags{}
+	for f := range ffFractionalsSet {
+		ff[flagsToFractionals[f]] = f
+	}
+	return ff
+}()
+
+// FractionalsFlag returns the ..._FRACTIONALS flag of given number of
+// fractionals.  It fails with an [ErrFractionals] if n is negative or
+// greater than [MaxFractionals].
+func FractionalsFlag(n int) (Flags, error) {
+	f, ok := fractionalsToFlags[n]
+	if !ok {
+		return 0, ErrFractionals
+	}
+	return f, nil
 }
 
 const ffSeparators = COMMA_SEPARATOR | DOT_SEPARATOR
 
+const ffRoundings = HALF_EVEN | HALF_UP | HALF_DOWN | UP | DOWN |
+	CEILING | FLOOR | HALF_AWAY
+
+var ffRoundingsSet = map[Flags]bool{
+	HALF_EVEN: true,
+	HALF_UP:   true,
+	HALF_DOWN: true,
+	UP:        true,
+	DOWN:      true,
+	CEILING:   true,
+	FLOOR:     true,
+	HALF_AWAY: true,
+}
+
 var ffSeparatorsSet = map[Flags]bool{
 	COMMA_SEPARATOR: true,
 	DOT_SEPARATOR:   true,
@@ -95,17 +204,6 @@ func newFlags(c *UContext) *flags {
 	return &ff
 }
 
-func (ff *flags) copy(ia, fmt Flags) *flags {
-	_ff := flags{art: ff.art, fmt: ff.fmt}
-	if ia != DEFAULTS {
-		_ff.art.set(ia)
-	}
-	if fmt != DEFAULTS {
-		_ff.fmt.set(fmt)
-	}
-	return &_ff
-}
-
 // SetFmt sets the flags controlling the string formatting of a
 // Decimal-value.  Note SetFmt(Default) is an no-op and if more than one
 // separator constant or more than one fractional positions constant is
@@ -122,3 +220,12 @@ func (ff *flags) Fractionals() int {
 func (ff *flags) FmtFractionals() int {
 	return flagsToFractionals[ff.fmt&ffFractionals]
 }
+
+// Rounding returns the rounding mode of the arithmetic flags or given
+// default mode if none is set.
+func (ff *flags) Rounding(dflt Flags) Flags {
+	if r := ff.art & ffRoundings; r != 0 {
+		return r
+	}
+	return dflt
+}
@@ -0,0 +1,368 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+// Package graph provides graph algorithms on adjacency lists whose
+// vertices' neighbours are [ints.Set]s.  A graph's vertices are the
+// integers 0 to n-1; frontiers, visited vertices, components and
+// cliques are [ints.Set]s too.
+package graph
+
+import (
+	"container/heap"
+	"errors"
+	"fmt"
+	"math/bits"
+	"sort"
+
+	"github.com/slukits/ints"
+)
+
+// ErrCycle is returned by [Graph.TopoSort] if the graph has a cycle.
+var ErrCycle = errors.New("ints: graph: topological sort: cycle")
+
+// ErrVertex is the panic value of [Graph.AddEdge] for an edge whose
+// endpoint isn't a vertex of the graph.
+var ErrVertex = errors.New("ints: graph: add edge: no such vertex")
+
+// Graph is a directed or undirected graph of the vertices 0 to n-1
+// whose vertices' neighbours are [ints.Set]s.  Create a graph by [New]
+// or [NewDirected].
+type Graph struct {
+	adj      []*ints.Set
+	directed bool
+}
+
+// New returns an undirected graph with given number of vertices and
+// no edges.
+func New(n int) *Graph {
+	g := &Graph{adj: make([]*ints.Set, n)}
+	for v := range g.adj {
+		g.adj[v] = &ints.Set{}
+	}
+	return g
+}
+
+// NewDirected returns a directed graph with given number of vertices
+// and no edges.
+func NewDirected(n int) *Graph {
+	g := New(n)
+	g.directed = true
+	return g
+}
+
+// Len returns the graph's number of vertices.
+func (g *Graph) Len() int { return len(g.adj) }
+
+// IsDirected returns true if the graph is directed.
+func (g *Graph) IsDirected() bool { return g.directed }
+
+// AddEdge adds the edge from u to v, i.e. v becomes a neighbour of u
+// and in an undirected graph u a neighbour of v.  AddEdge panics with
+// an [ErrVertex] if u or v is not in [0, n).
+func (g *Graph) AddEdge(u, v int) *Graph {
+	if u < 0 || u >= g.Len() || v < 0 || v >= g.Len() {
+		panic(fmt.Errorf("%w: %d -> %d", ErrVertex, u, v))
+	}
+	g.adj[u].Add(v)
+	if !g.directed {
+		g.adj[v].Add(u)
+	}
+	return g
+}
+
+// Neighbours returns a copy of given vertex's neighbours.
+func (g *Graph) Neighbours(v int) *ints.Set { return clone(g.adj[v]) }
+
+// BFS visits the vertices reachable from given start vertex in breadth
+// first order providing a vertex and its distance to start.  It
+// returns the visited vertices.
+func (g *Graph) BFS(start int, visit func(v, depth int)) *ints.Set {
+	visited := (&ints.Set{}).Add(start)
+	frontier := []int{start}
+	for depth := 0; len(frontier) > 0; depth++ {
+		var next []int
+		for _, v := range frontier {
+			visit(v, depth)
+			g.adj[v].For(func(n int) {
+				if visited.Has(n) {
+					return
+				}
+				visited.Add(n)
+				next = append(next, n)
+			})
+		}
+		frontier = next
+	}
+	return visited
+}
+
+// DFS visits the vertices reachable from given start vertex in depth
+// first pre-order whereas neighbours are visited in ascending order.
+// It returns the visited vertices.
+func (g *Graph) DFS(start int, visit func(v int)) *ints.Set {
+	visited := &ints.Set{}
+	g.dfs(start, visited, visit)
+	return visited
+}
+
+func (g *Graph) dfs(v int, visited *ints.Set, visit func(int)) {
+	visited.Add(v)
+	visit(v)
+	g.adj[v].For(func(n int) {
+		if !visited.Has(n) {
+			g.dfs(n, visited, visit)
+		}
+	})
+}
+
+// Components returns the connected components of the graph ordered by
+// their smallest vertex.  The components of a directed graph are its
+// weakly connected components.
+func (g *Graph) Components() []*ints.Set {
+	u := g
+	if g.directed {
+		u = New(g.Len())
+		for v, nn := range g.adj {
+			nn.For(func(n int) { u.AddEdge(v, n) })
+		}
+	}
+	var cc []*ints.Set
+	seen := &ints.Set{}
+	for v := range u.adj {
+		if seen.Has(v) {
+			continue
+		}
+		c := &ints.Set{}
+		u.dfs(v, seen, func(n int) { c.Add(n) })
+		cc = append(cc, c)
+	}
+	return cc
+}
+
+// TopoSort returns the vertices of a directed graph in topological
+// order whereas of the vertices whose predecessors are ordered the
+// smallest comes first.  It fails with [ErrCycle] if the graph has a
+// cycle.
+func (g *Graph) TopoSort() ([]int, error) {
+	in := make([]int, g.Len())
+	for _, nn := range g.adj {
+		nn.For(func(n int) { in[n]++ })
+	}
+	ready := &minHeap{}
+	for v, d := range in {
+		if d == 0 {
+			heap.Push(ready, v)
+		}
+	}
+	order := make([]int, 0, g.Len())
+	for ready.Len() > 0 {
+		v := heap.Pop(ready).(int)
+		order = append(order, v)
+		g.adj[v].For(func(n int) {
+			if in[n]--; in[n] == 0 {
+				heap.Push(ready, n)
+			}
+		})
+	}
+	if len(order) != g.Len() {
+		return nil, ErrCycle
+	}
+	return order, nil
+}
+
+// minHeap implements heap.Interface for the vertices ready to be
+// sorted topologically.
+type minHeap []int
+
+func (h minHeap) Len() int            { return len(h) }
+func (h minHeap) Less(i, j int) bool  { return h[i] < h[j] }
+func (h minHeap) Swap(i, j int)       { h[i], h[j] = h[j], h[i] }
+func (h *minHeap) Push(v interface{}) { *h = append(*h, v.(int)) }
+
+func (h *minHeap) Pop() interface{} {
+	v := (*h)[len(*h)-1]
+	*h = (*h)[:len(*h)-1]
+	return v
+}
+
+// Distances returns for each of given source vertices the distance of
+// every vertex from that source whereas unreachable vertices have
+// distance -1.  Up to 64 sources are searched at once by a bit-parallel
+// breadth first search, i.e. each vertex holds a word whose bit i tells
+// if it was reached from the i-th source.
+func (g *Graph) Distances(sources ...int) [][]int {
+	dist := make([][]int, len(sources))
+	for i := range dist {
+		dist[i] = make([]int, g.Len())
+		for v := range dist[i] {
+			dist[i][v] = -1
+		}
+	}
+	for lo := 0; lo < len(sources); lo += 64 {
+		hi := lo + 64
+		if hi > len(sources) {
+			hi = len(sources)
+		}
+		g.msBFS(sources[lo:hi], dist[lo:hi])
+	}
+	return dist
+}
+
+// msBFS is the multi-source bit-parallel breadth first search of up to
+// 64 given sources.
+func (g *Graph) msBFS(sources []int, dist [][]int) {
+	seen := make([]uint64, g.Len())
+	frontier := make([]uint64, g.Len())
+	for i, s := range sources {
+		seen[s] |= 1 << i
+		frontier[s] |= 1 << i
+	}
+	for depth := 0; ; depth++ {
+		active, next := false, make([]uint64, g.Len())
+		for v, f := range frontier {
+			if f == 0 {
+				continue
+			}
+			active = true
+			for w := f; w != 0; w &= w - 1 {
+				dist[bits.TrailingZeros64(w)][v] = depth
+			}
+			g.adj[v].For(func(n int) { next[n] |= f })
+		}
+		if !active {
+			return
+		}
+		for v := range next {
+			next[v] &^= seen[v]
+			seen[v] |= next[v]
+		}
+		frontier = next
+	}
+}
+
+// MaximalCliques returns all maximal cliques of an undirected graph by
+// the Bron–Kerbosch algorithm with pivoting.  The cliques are ordered
+// by their ascending elements.
+func (g *Graph) MaximalCliques() []*ints.Set {
+	var cc []*ints.Set
+	all := &ints.Set{}
+	for v := range g.adj {
+		all.Add(v)
+	}
+	g.bronKerbosch(&ints.Set{}, all, &ints.Set{}, func(c *ints.Set) {
+		cc = append(cc, c)
+	})
+	sort.Slice(cc, func(i, j int) bool {
+		a, b := cc[i].ToSlice(), cc[j].ToSlice()
+		for k := 0; k < len(a) && k < len(b); k++ {
+			if a[k] != b[k] {
+				return a[k] < b[k]
+			}
+		}
+		return len(a) < len(b)
+	})
+	return cc
+}
+
+// neighbours returns given vertex's neighbours without itself.
+func (g *Graph) neighbours(v int) *ints.Set {
+	return diff(g.adj[v], (&ints.Set{}).Add(v))
+}
+
+func (g *Graph) bronKerbosch(r, p, x *ints.Set, report func(*ints.Set)) {
+	if p.IsEmpty() && x.IsEmpty() {
+		report(r)
+		return
+	}
+	pivot, max := -1, -1
+	union(p, x).For(func(u int) {
+		if n := intersect(p, g.neighbours(u)).Len(); n > max {
+			pivot, max = u, n
+		}
+	})
+	diff(p, g.neighbours(pivot)).For(func(v int) {
+		nn := g.neighbours(v)
+		g.bronKerbosch(clone(r).Add(v), intersect(p, nn), intersect(x, nn),
+			report)
+		p = diff(p, (&ints.Set{}).Add(v))
+		x = union(x, (&ints.Set{}).Add(v))
+	})
+}
+
+// Coloring returns a greedy coloring of an undirected graph, i.e.
+// colors[v] is the color of vertex v and no two neighbours have the
+// same color, and the number of used colors.  Vertices are colored in
+// order of descending degree (Welsh–Powell) with the smallest color not
+// used by a neighbour.
+func (g *Graph) Coloring() (colors []int, n int) {
+	order := make([]int, g.Len())
+	for v := range order {
+		order[v] = v
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return g.adj[order[i]].Len() > g.adj[order[j]].Len()
+	})
+	colors = make([]int, g.Len())
+	for v := range colors {
+		colors[v] = -1
+	}
+	for _, v := range order {
+		used := &ints.Set{}
+		g.adj[v].For(func(n int) {
+			if colors[n] >= 0 {
+				used.Add(colors[n])
+			}
+		})
+		c := 0
+		for used.Has(c) {
+			c++
+		}
+		colors[v] = c
+		if c+1 > n {
+			n = c + 1
+		}
+	}
+	return colors, n
+}
+
+// clone returns a copy of given set.
+func clone(s *ints.Set) *ints.Set { return ints.FromWords(s.Words()) }
+
+// union returns a new set with the elements of given sets.
+func union(a, b *ints.Set) *ints.Set {
+	return combine(a, b, func(x, y uint64) uint64 { return x | y })
+}
+
+// intersect returns a new set with the elements which are in both given
+// sets.
+func intersect(a, b *ints.Set) *ints.Set {
+	return combine(a, b, func(x, y uint64) uint64 { return x & y })
+}
+
+// diff returns a new set with the elements of a which are not in b.
+func diff(a, b *ints.Set) *ints.Set {
+	return combine(a, b, func(x, y uint64) uint64 { return x &^ y })
+}
+
+// combine returns the set whose words are the results of given
+// operation on the corresponding words of given sets.
+func combine(a, b *ints.Set, op func(x, y uint64) uint64) *ints.Set {
+	aa, bb := a.Words(), b.Words()
+	n := len(aa)
+	if len(bb) > n {
+		n = len(bb)
+	}
+	ww := make([]uint64, n)
+	for i := range ww {
+		var x, y uint64
+		if i < len(aa) {
+			x = aa[i]
+		}
+		if i < len(bb) {
+			y = bb[i]
+		}
+		ww[i] = op(x, y)
+	}
+	return ints.FromWords(ww)
+}
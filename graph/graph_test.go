@@ -0,0 +1,141 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package graph
+
+import (
+	"testing"
+
+	. "github.com/slukits/gounit"
+)
+
+type graph struct{ Suite }
+
+func (s *graph) SetUp(t *T) { t.Parallel() }
+
+// house returns the undirected "house" graph: a square 0-1-2-3 with the
+// roof 2-3-4 and the isolated vertex 5.
+func house() *Graph {
+	return New(6).AddEdge(0, 1).AddEdge(1, 2).AddEdge(2, 3).
+		AddEdge(3, 0).AddEdge(2, 4).AddEdge(3, 4)
+}
+
+func (s *graph) Has_symmetric_edges_if_undirected(t *T) {
+	g := house()
+	t.Eq(6, g.Len())
+	t.Eq("{0, 2}", g.Neighbours(1).String())
+	t.Eq("{1}", NewDirected(2).AddEdge(0, 1).Neighbours(0).String())
+	t.Eq("{}", NewDirected(2).AddEdge(0, 1).Neighbours(1).String())
+}
+
+func (s *graph) Rejects_edges_to_unknown_vertices(t *T) {
+	for _, g := range []*Graph{New(2), NewDirected(2)} {
+		for _, e := range [][2]int{{0, 2}, {2, 0}, {0, -1}, {-1, 0}} {
+			func() {
+				defer func() {
+					err, _ := recover().(error)
+					t.ErrIs(err, ErrVertex)
+				}()
+				g.AddEdge(e[0], e[1])
+			}()
+		}
+		t.True(g.Neighbours(0).IsEmpty())
+	}
+}
+
+func (s *graph) BFS_visits_vertices_by_distance(t *T) {
+	var vv, dd []int
+	visited := house().BFS(0, func(v, d int) {
+		vv, dd = append(vv, v), append(dd, d)
+	})
+	t.Eq([]int{0, 1, 3, 2, 4}, vv)
+	t.Eq([]int{0, 1, 1, 2, 2}, dd)
+	t.Eq("{0, 1, 2, 3, 4}", visited.String())
+}
+
+func (s *graph) DFS_visits_vertices_in_pre_order(t *T) {
+	var vv []int
+	visited := house().DFS(0, func(v int) { vv = append(vv, v) })
+	t.Eq([]int{0, 1, 2, 3, 4}, vv)
+	t.Eq(5, visited.Len())
+}
+
+func (s *graph) Components_are_ordered_by_smallest_vertex(t *T) {
+	cc := house().Components()
+	t.Eq(2, len(cc))
+	t.Eq("{0, 1, 2, 3, 4}", cc[0].String())
+	t.Eq("{5}", cc[1].String())
+	cc = NewDirected(4).AddEdge(1, 0).AddEdge(3, 2).Components()
+	t.Eq(2, len(cc))
+	t.Eq("{2, 3}", cc[1].String())
+}
+
+func (s *graph) Sorts_directed_acyclic_graph_topologically(t *T) {
+	g := NewDirected(5).AddEdge(3, 1).AddEdge(1, 0).AddEdge(4, 0).
+		AddEdge(2, 4)
+	order, err := g.TopoSort()
+	t.FatalOn(err)
+	t.Eq([]int{2, 3, 1, 4, 0}, order)
+	_, err = g.AddEdge(0, 3).TopoSort()
+	t.ErrIs(err, ErrCycle)
+}
+
+func (s *graph) Calculates_distances_from_many_sources(t *T) {
+	dd := house().Distances(0, 4, 5)
+	t.Eq([]int{0, 1, 2, 1, 2, -1}, dd[0])
+	t.Eq([]int{2, 2, 1, 1, 0, -1}, dd[1])
+	t.Eq([]int{-1, -1, -1, -1, -1, 0}, dd[2])
+
+	path := New(100)
+	for v := 1; v < 100; v++ {
+		path.AddEdge(v-1, v)
+	}
+	sources := make([]int, 100)
+	for i := range sources {
+		sources[i] = i
+	}
+	dd = path.Distances(sources...)
+	for i, d := range dd {
+		for v := range d {
+			if v >= i {
+				t.Eq(v-i, d[v])
+			} else {
+				t.Eq(i-v, d[v])
+			}
+		}
+	}
+}
+
+func (s *graph) Enumerates_maximal_cliques(t *T) {
+	var ss []string
+	for _, c := range house().MaximalCliques() {
+		ss = append(ss, c.String())
+	}
+	t.Eq([]string{"{0, 1}", "{0, 3}", "{1, 2}", "{2, 3, 4}", "{5}"}, ss)
+	k4 := New(4).AddEdge(0, 1).AddEdge(0, 2).AddEdge(0, 3).
+		AddEdge(1, 2).AddEdge(1, 3).AddEdge(2, 3)
+	t.Eq(1, len(k4.MaximalCliques()))
+}
+
+func (s *graph) Ignores_self_loops_enumerating_cliques(t *T) {
+	triangle := New(3).AddEdge(0, 1).AddEdge(1, 2).AddEdge(2, 0).
+		AddEdge(0, 0)
+	cc := triangle.MaximalCliques()
+	t.Eq(1, len(cc))
+	t.Eq("{0, 1, 2}", cc[0].String())
+}
+
+func (s *graph) Colors_neighbours_differently(t *T) {
+	g := house()
+	colors, n := g.Coloring()
+	t.Eq(3, n)
+	for v := range colors {
+		g.Neighbours(v).For(func(u int) { t.True(colors[u] != colors[v]) })
+	}
+}
+
+func TestGraph(t *testing.T) {
+	t.Parallel()
+	Run(&graph{}, t)
+}
@@ -0,0 +1,234 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package ints
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Interval represents the half open interval [Lo, Hi) of int64
+// coordinates.  An interval with Hi <= Lo is empty.
+type Interval struct{ Lo, Hi int64 }
+
+// Len returns the number of integers in given interval as uint64
+// since an interval like [math.MinInt64, math.MaxInt64) has more than
+// math.MaxInt64 integers.
+func (i Interval) Len() uint64 {
+	if i.Hi <= i.Lo {
+		return 0
+	}
+	return uint64(i.Hi) - uint64(i.Lo)
+}
+
+// IntervalSet is a set of int64 integers stored as sorted disjoint
+// intervals which makes it a companion of [Set] for data made of long
+// contiguous runs like port ranges or byte ranges of a file.  The zero
+// value is ready to use.
+type IntervalSet struct {
+	ii []Interval // sorted, disjoint and not adjacent
+}
+
+// IntervalSetFromSet constructs an interval set from given set's runs
+// of consecutive elements.
+func IntervalSetFromSet(s *Set) *IntervalSet {
+	is := &IntervalSet{}
+	s.forRuns(func(lo, hi int) {
+		is.ii = append(is.ii, Interval{int64(lo), int64(hi)})
+	})
+	return is
+}
+
+// ToSet converts receiving interval set to a [Set] whereas negative
+// integers are ignored.  Note that a set's memory usage grows with its
+// greatest element.
+func (is *IntervalSet) ToSet() *Set {
+	s := &Set{}
+	for _, i := range is.ii {
+		s.addRange(int(i.Lo), int(i.Hi))
+	}
+	return s
+}
+
+// Intervals returns a copy of receiving set's sorted disjoint
+// intervals.
+func (is *IntervalSet) Intervals() []Interval {
+	return append([]Interval(nil), is.ii...)
+}
+
+// Len returns the number of integers in receiving set as uint64 which
+// can't overflow since the set's disjoint intervals are int64 ranges.
+func (is *IntervalSet) Len() (n uint64) {
+	for _, i := range is.ii {
+		n += i.Len()
+	}
+	return n
+}
+
+// IsEmpty returns true if receiving set has no elements.
+func (is *IntervalSet) IsEmpty() bool { return len(is.ii) == 0 }
+
+// Has returns true if given integer is in receiving set.
+func (is *IntervalSet) Has(e int64) bool {
+	k := sort.Search(len(is.ii), func(k int) bool {
+		return is.ii[k].Hi > e
+	})
+	return k < len(is.ii) && is.ii[k].Lo <= e
+}
+
+// Add adds the integers of [lo, hi) to receiving set merging
+// overlapping and adjacent intervals.
+func (is *IntervalSet) Add(lo, hi int64) *IntervalSet {
+	if hi <= lo {
+		return is
+	}
+	// first interval which ends at or after lo and first which starts
+	// after hi are the bounds of the intervals merged with [lo, hi)
+	a := sort.Search(len(is.ii), func(k int) bool {
+		return is.ii[k].Hi >= lo
+	})
+	b := sort.Search(len(is.ii), func(k int) bool {
+		return is.ii[k].Lo > hi
+	})
+	if a < b {
+		if is.ii[a].Lo < lo {
+			lo = is.ii[a].Lo
+		}
+		if is.ii[b-1].Hi > hi {
+			hi = is.ii[b-1].Hi
+		}
+	}
+	is.ii = append(is.ii[:a], append([]Interval{{lo, hi}},
+		is.ii[b:]...)...)
+	return is
+}
+
+// Del removes the integers of [lo, hi) from receiving set.
+func (is *IntervalSet) Del(lo, hi int64) *IntervalSet {
+	if hi <= lo {
+		return is
+	}
+	a := sort.Search(len(is.ii), func(k int) bool {
+		return is.ii[k].Hi > lo
+	})
+	b := sort.Search(len(is.ii), func(k int) bool {
+		return is.ii[k].Lo >= hi
+	})
+	if a >= b {
+		return is
+	}
+	var rest []Interval
+	if is.ii[a].Lo < lo {
+		rest = append(rest, Interval{is.ii[a].Lo, lo})
+	}
+	if is.ii[b-1].Hi > hi {
+		rest = append(rest, Interval{hi, is.ii[b-1].Hi})
+	}
+	is.ii = append(is.ii[:a], append(rest, is.ii[b:]...)...)
+	return is
+}
+
+// Union returns a new set with the integers of receiving and given
+// other set.
+func (is *IntervalSet) Union(other *IntervalSet) *IntervalSet {
+	u := &IntervalSet{ii: is.Intervals()}
+	for _, i := range other.ii {
+		u.Add(i.Lo, i.Hi)
+	}
+	return u
+}
+
+// Intersect returns a new set with the integers which are in receiving
+// and in given other set.
+func (is *IntervalSet) Intersect(other *IntervalSet) *IntervalSet {
+	x, a, b := &IntervalSet{}, 0, 0
+	for a < len(is.ii) && b < len(other.ii) {
+		lo, hi := is.ii[a].Lo, is.ii[a].Hi
+		if other.ii[b].Lo > lo {
+			lo = other.ii[b].Lo
+		}
+		if other.ii[b].Hi < hi {
+			hi = other.ii[b].Hi
+		}
+		if lo < hi {
+			x.ii = append(x.ii, Interval{lo, hi})
+		}
+		if is.ii[a].Hi < other.ii[b].Hi {
+			a++
+		} else {
+			b++
+		}
+	}
+	return x
+}
+
+// Diff returns a new set with the integers of receiving set which are
+// not in given other set.
+func (is *IntervalSet) Diff(other *IntervalSet) *IntervalSet {
+	d := &IntervalSet{ii: is.Intervals()}
+	for _, i := range other.ii {
+		d.Del(i.Lo, i.Hi)
+	}
+	return d
+}
+
+// Gaps returns the maximal intervals within [lo, hi) containing no
+// integer of receiving set.
+func (is *IntervalSet) Gaps(lo, hi int64) []Interval {
+	var gg []Interval
+	for _, i := range is.Intersect((&IntervalSet{}).Add(lo, hi)).ii {
+		if lo < i.Lo {
+			gg = append(gg, Interval{lo, i.Lo})
+		}
+		lo = i.Hi
+	}
+	if lo < hi {
+		gg = append(gg, Interval{lo, hi})
+	}
+	return gg
+}
+
+// For calls back for each interval of receiving set in ascending
+// order.
+func (is *IntervalSet) For(cb func(Interval)) {
+	for _, i := range is.ii {
+		cb(i)
+	}
+}
+
+// ForElements calls back for each integer of receiving set in
+// ascending order.
+func (is *IntervalSet) ForElements(cb func(int64)) {
+	for _, i := range is.ii {
+		for e := i.Lo; e < i.Hi; e++ {
+			cb(e)
+		}
+	}
+}
+
+// Eq returns true if receiving set has the same integers as given
+// other set.
+func (is *IntervalSet) Eq(other *IntervalSet) bool {
+	if len(is.ii) != len(other.ii) {
+		return false
+	}
+	for k, i := range is.ii {
+		if other.ii[k] != i {
+			return false
+		}
+	}
+	return true
+}
+
+// String returns an interval set's string representation
+// {[lo1, hi1), [lo2, hi2), ...}.
+func (is *IntervalSet) String() string {
+	var ii []string
+	for _, i := range is.ii {
+		ii = append(ii, fmt.Sprintf("[%d, %d)", i.Lo, i.Hi))
+	}
+	return "{" + strings.Join(ii, ", ") + "}"
+}
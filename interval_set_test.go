@@ -0,0 +1,104 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package ints
+
+import (
+	"math"
+	"testing"
+
+	. "github.com/slukits/gounit"
+)
+
+type intervalSet struct{ Suite }
+
+func (s *intervalSet) SetUp(t *T) { t.Parallel() }
+
+func (s *intervalSet) Is_initially_empty(t *T) {
+	var fx IntervalSet
+	t.True(fx.IsEmpty())
+	t.Eq(uint64(0), fx.Len())
+	t.Not.True(fx.Has(0))
+}
+
+func (s *intervalSet) Merges_overlapping_and_adjacent_intervals(t *T) {
+	is := (&IntervalSet{}).Add(10, 20).Add(30, 40).Add(50, 60)
+	t.Eq("{[10, 20), [30, 40), [50, 60)}", is.String())
+	is.Add(20, 30)
+	t.Eq("{[10, 40), [50, 60)}", is.String())
+	is.Add(5, 55)
+	t.Eq("{[5, 60)}", is.String())
+	is.Add(70, 70)
+	t.Eq("{[5, 60)}", is.String())
+}
+
+func (s *intervalSet) Has_integers_of_added_intervals(t *T) {
+	is := (&IntervalSet{}).Add(10, 20).Add(30, 40)
+	for e, has := range map[int64]bool{
+		9: false, 10: true, 19: true, 20: false, 30: true, 40: false} {
+		t.Eq(has, is.Has(e))
+	}
+	t.Eq(uint64(20), is.Len())
+}
+
+func (s *intervalSet) Splits_intervals_on_deletion(t *T) {
+	is := (&IntervalSet{}).Add(0, 100)
+	is.Del(10, 20).Del(50, 60)
+	t.Eq("{[0, 10), [20, 50), [60, 100)}", is.String())
+	is.Del(5, 70)
+	t.Eq("{[0, 5), [70, 100)}", is.String())
+	is.Del(200, 300).Del(0, 1000)
+	t.True(is.IsEmpty())
+}
+
+func (s *intervalSet) Supports_int64_coordinates(t *T) {
+	is := (&IntervalSet{}).Add(math.MinInt64, -1<<40).Add(1<<50, math.MaxInt64)
+	t.True(is.Has(-1 << 41))
+	t.True(is.Has(1 << 60))
+	t.Not.True(is.Has(0))
+	t.Eq(uint64(math.MaxUint64), Interval{math.MinInt64, math.MaxInt64}.Len())
+	t.Eq(uint64(1<<63-1<<40)+uint64(1<<63-1-1<<50), is.Len())
+}
+
+func (s *intervalSet) Combines_with_other_interval_set(t *T) {
+	a := (&IntervalSet{}).Add(0, 10).Add(20, 30)
+	b := (&IntervalSet{}).Add(5, 25)
+	t.Eq("{[0, 30)}", a.Union(b).String())
+	t.Eq("{[5, 10), [20, 25)}", a.Intersect(b).String())
+	t.Eq("{[0, 5), [25, 30)}", a.Diff(b).String())
+	t.Eq("{[0, 10), [20, 30)}", a.String())
+}
+
+func (s *intervalSet) Provides_gaps_within_bound(t *T) {
+	is := (&IntervalSet{}).Add(10, 20).Add(30, 40)
+	t.Eq([]Interval{{0, 10}, {20, 30}, {40, 50}}, is.Gaps(0, 50))
+	t.Eq([]Interval{{20, 30}}, is.Gaps(15, 35))
+	t.Eq(0, len(is.Gaps(10, 20)))
+}
+
+func (s *intervalSet) Iterates_intervals_and_elements(t *T) {
+	is := (&IntervalSet{}).Add(1, 3).Add(7, 8)
+	var ii []Interval
+	is.For(func(i Interval) { ii = append(ii, i) })
+	t.Eq([]Interval{{1, 3}, {7, 8}}, ii)
+	var ee []int64
+	is.ForElements(func(e int64) { ee = append(ee, e) })
+	t.Eq([]int64{1, 2, 7}, ee)
+}
+
+func (s *intervalSet) Converts_from_and_to_set(t *T) {
+	st := FromSlice([]int{1, 2, 3, 7, 64, 65})
+	is := IntervalSetFromSet(st)
+	t.Eq("{[1, 4), [7, 8), [64, 66)}", is.String())
+	t.True(is.ToSet().Eq(st))
+	t.True(IntervalSetFromSet(is.ToSet()).Eq(is))
+	neg := (&IntervalSet{}).Add(-10, 3).Add(60, 200)
+	t.Eq([]int{0, 1, 2}, neg.ToSet().ToSlice()[:3])
+	t.Eq(143, neg.ToSet().Len())
+}
+
+func TestIntervalSet(t *testing.T) {
+	t.Parallel()
+	Run(&intervalSet{}, t)
+}
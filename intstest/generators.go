@@ -0,0 +1,192 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+// Package intstest provides utilities for property based testing of
+// the [ints.Set] and [ints.UDecimal] types and of extensions to them:
+// [testing/quick] generators, fuzz seed corpora and law checkers which
+// validate the invariants the ints package guarantees.
+//
+//	if err := intstest.CheckSetLaws(nil); err != nil {
+//	    t.Fatal(err)
+//	}
+//	if err := intstest.CheckUContextLaws(myContext, nil); err != nil {
+//	    t.Fatal(err)
+//	}
+package intstest
+
+import (
+	"math/rand"
+	"reflect"
+
+	"github.com/slukits/ints"
+)
+
+// Set wraps an [ints.Set] to implement the [quick.Generator] interface
+// generating sparse, dense or edge of word sets with equal probability.
+type Set struct{ *ints.Set }
+
+// Generate implements the [quick.Generator] interface.
+func (Set) Generate(rng *rand.Rand, size int) reflect.Value {
+	switch rng.Intn(3) {
+	case 0:
+		return reflect.ValueOf(Set{sparse(rng, size)})
+	case 1:
+		return reflect.ValueOf(Set{dense(rng, size)})
+	}
+	return reflect.ValueOf(Set{edgeOfWord(rng, size)})
+}
+
+// Sparse wraps an [ints.Set] to implement the [quick.Generator]
+// interface generating sets with few elements spread over a large
+// range.
+type Sparse struct{ *ints.Set }
+
+// Generate implements the [quick.Generator] interface.
+func (Sparse) Generate(rng *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(Sparse{sparse(rng, size)})
+}
+
+// Dense wraps an [ints.Set] to implement the [quick.Generator]
+// interface generating sets most of whose integers of a range are
+// elements.
+type Dense struct{ *ints.Set }
+
+// Generate implements the [quick.Generator] interface.
+func (Dense) Generate(rng *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(Dense{dense(rng, size)})
+}
+
+// EdgeOfWord wraps an [ints.Set] to implement the [quick.Generator]
+// interface generating sets whose elements are at the boundaries of
+// 32 and 64 bit words.
+type EdgeOfWord struct{ *ints.Set }
+
+// Generate implements the [quick.Generator] interface.
+func (EdgeOfWord) Generate(rng *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(EdgeOfWord{edgeOfWord(rng, size)})
+}
+
+func sparse(rng *rand.Rand, size int) *ints.Set {
+	s, n := &ints.Set{}, rng.Intn(size/4+2)
+	for i := 0; i < n; i++ {
+		s.Add(rng.Intn(256 * (size + 1)))
+	}
+	return s
+}
+
+func dense(rng *rand.Rand, size int) *ints.Set {
+	s, lo := &ints.Set{}, rng.Intn(128)
+	for i := lo; i < lo+rng.Intn(4*(size+1)); i++ {
+		if rng.Intn(8) != 0 {
+			s.Add(i)
+		}
+	}
+	return s
+}
+
+func edgeOfWord(rng *rand.Rand, size int) *ints.Set {
+	s, n := &ints.Set{}, rng.Intn(size/4+2)
+	for i := 0; i < n; i++ {
+		w := 32 * (rng.Intn(size/8+1) + 1)
+		s.Add(w - 1 + rng.Intn(3))
+	}
+	return s
+}
+
+// Decimals returns a function for [quick.Config].Values generating
+// [ints.UDecimal] values of given context, i.e. the arguments of a
+// property must all be UDecimals.  A generated value is with equal
+// probability an edge value like zero, one, [ints.UContext].Max or
+// values near them, a value near a power of ten or a uniformly chosen
+// value up to Max.
+func Decimals(c *ints.UContext) func([]reflect.Value, *rand.Rand) {
+	return func(vv []reflect.Value, rng *rand.Rand) {
+		for i := range vv {
+			vv[i] = reflect.ValueOf(Decimal(c, rng))
+		}
+	}
+}
+
+// Decimal returns a random [ints.UDecimal] of given context as
+// described at [Decimals].
+func Decimal(c *ints.UContext, rng *rand.Rand) ints.UDecimal {
+	switch rng.Intn(3) {
+	case 0:
+		edges := decimalEdges(c)
+		return edges[rng.Intn(len(edges))]
+	case 1:
+		d, max := ints.UDecimal(1), c.Max/10
+		for d <= max && rng.Intn(4) != 0 {
+			d *= 10
+		}
+		return d - 1 + ints.UDecimal(rng.Intn(3))
+	}
+	return ints.UDecimal(rng.Uint64() % (uint64(c.Max) + 1))
+}
+
+// decimalEdges returns the edge values of given context.
+func decimalEdges(c *ints.UContext) []ints.UDecimal {
+	one := one(c)
+	return []ints.UDecimal{0, 1, 2, one - 1, one, one + 1, 2 * one,
+		c.Max / 2, c.Max/2 + 1, c.Max - one, c.Max - 1, c.Max}
+}
+
+// one returns the decimal one of given context.
+func one(c *ints.UContext) ints.UDecimal { return c.From.MInts(1, 0, 0) }
+
+// SetSeeds returns a fuzz seed corpus of sparse, dense and edge of word
+// sets encoded as little-endian bitmaps, see [SetFromBytes].
+//
+//	for _, seed := range intstest.SetSeeds() {
+//	    f.Add(seed)
+//	}
+//	f.Fuzz(func(t *testing.T, bb []byte) {
+//	    s := intstest.SetFromBytes(bb)
+//	    ...
+//	})
+func SetSeeds() [][]byte {
+	rng := rand.New(rand.NewSource(1))
+	seeds := [][]byte{{}, {1}, {0x80}, {0, 0, 0, 0x80, 1},
+		{0, 0, 0, 0, 0, 0, 0, 0x80, 1}, {0xff, 0xff, 0xff, 0xff, 0xff,
+			0xff, 0xff, 0xff, 0xff}}
+	for _, s := range []*ints.Set{sparse(rng, 50), dense(rng, 50),
+		edgeOfWord(rng, 50)} {
+		seeds = append(seeds, bitmap(s))
+	}
+	return seeds
+}
+
+// SetFromBytes returns the set of given little-endian bitmap, i.e. i is
+// an element iff bit i%8 of byte i/8 is set.
+func SetFromBytes(bb []byte) *ints.Set {
+	s := &ints.Set{}
+	for i, b := range bb {
+		for bit := 0; bit < 8; bit++ {
+			if b&(1<<bit) != 0 {
+				s.Add(8*i + bit)
+			}
+		}
+	}
+	return s
+}
+
+func bitmap(s *ints.Set) []byte {
+	var bb []byte
+	for _, w := range s.Words() {
+		for i := 0; i < 8; i++ {
+			bb = append(bb, byte(w>>(8*i)))
+		}
+	}
+	return bb
+}
+
+// DecimalSeeds returns a fuzz seed corpus of the edge values of given
+// context as uint64 values.
+func DecimalSeeds(c *ints.UContext) []uint64 {
+	var seeds []uint64
+	for _, d := range decimalEdges(c) {
+		seeds = append(seeds, uint64(d))
+	}
+	return seeds
+}
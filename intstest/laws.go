@@ -0,0 +1,229 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package intstest
+
+import (
+	"fmt"
+	"sort"
+	"testing/quick"
+
+	"github.com/slukits/ints"
+)
+
+// Law is an invariant of the ints package's types whose property
+// function is checked by [quick.Check].
+type Law struct {
+	Name string
+	Prop interface{}
+}
+
+// Check checks given laws with given configuration and returns the
+// first violation or nil.
+func Check(laws []Law, cfg *quick.Config) error {
+	for _, l := range laws {
+		if err := quick.Check(l.Prop, cfg); err != nil {
+			return fmt.Errorf("intstest: law %q: %w", l.Name, err)
+		}
+	}
+	return nil
+}
+
+// CheckSetLaws checks the [SetLaws] with given configuration which may
+// be nil.
+func CheckSetLaws(cfg *quick.Config) error { return Check(SetLaws, cfg) }
+
+// CheckUContextLaws checks the [UContextLaws] of given context with
+// given configuration which may be nil.  The configuration's Values
+// function is replaced by the [Decimals] generator of given context.
+func CheckUContextLaws(c *ints.UContext, cfg *quick.Config) error {
+	_cfg := quick.Config{}
+	if cfg != nil {
+		_cfg = *cfg
+	}
+	_cfg.Values = Decimals(c)
+	return Check(UContextLaws(c), &_cfg)
+}
+
+// SetLaws are the invariants of the [ints.Set] type whereas unions,
+// intersections and differences are calculated on the sets' words (see
+// [ints.Set.Words]).
+var SetLaws = []Law{
+	{"Union is commutative", func(a, b Set) bool {
+		return union(a.Set, b.Set).Eq(union(b.Set, a.Set))
+	}},
+	{"Union is associative", func(a, b, c Set) bool {
+		return union(a.Set, union(b.Set, c.Set)).Eq(
+			union(union(a.Set, b.Set), c.Set))
+	}},
+	{"Intersect is commutative", func(a, b Set) bool {
+		return intersect(a.Set, b.Set).Eq(intersect(b.Set, a.Set))
+	}},
+	{"Intersect distributes over Union", func(a, b, c Set) bool {
+		return intersect(a.Set, union(b.Set, c.Set)).Eq(
+			union(intersect(a.Set, b.Set), intersect(a.Set, c.Set)))
+	}},
+	{"Union absorbs Intersect", func(a, b Set) bool {
+		return union(a.Set, intersect(a.Set, b.Set)).Eq(a.Set)
+	}},
+	{"Len of Union is inclusion-exclusion", func(a, b Set) bool {
+		return union(a.Set, b.Set).Len() ==
+			a.Len()+b.Len()-intersect(a.Set, b.Set).Len()
+	}},
+	{"Diff is disjoint from subtrahend", func(a, b Set) bool {
+		return intersect(diff(a.Set, b.Set), b.Set).IsEmpty()
+	}},
+	{"Diff and Intersect partition a set", func(a, b Set) bool {
+		return union(diff(a.Set, b.Set), intersect(a.Set, b.Set)).Eq(a.Set)
+	}},
+	{"Union has both sets as subsets", func(a, b Set) bool {
+		u := union(a.Set, b.Set)
+		return u.HasSub(a.Set) && u.HasSub(b.Set)
+	}},
+	{"Add then Del is identity for new elements", func(a Set, e uint16) bool {
+		if a.Has(int(e)) {
+			return true
+		}
+		return clone(a.Set).Add(int(e)).Del(int(e)).Eq(a.Set)
+	}},
+	{"ToSlice is sorted and has Len elements", func(a Set) bool {
+		ee := a.ToSlice()
+		return len(ee) == a.Len() && sort.IntsAreSorted(ee)
+	}},
+	{"FromSlice inverts ToSlice", func(a Set) bool {
+		return ints.FromSlice(a.ToSlice()).Eq(a.Set)
+	}},
+	{"ParseSet inverts RangeString", func(a Set) bool {
+		s, err := ints.ParseSet(a.RangeString())
+		return err == nil && s.Eq(a.Set)
+	}},
+	{"FromWords inverts Words", func(a Set) bool {
+		return ints.FromWords(a.Words()).Eq(a.Set)
+	}},
+	{"Applying a Diff patch transforms old into new", func(a, b Set) bool {
+		return clone(a.Set).Apply(ints.Diff(a.Set, b.Set)).Eq(b.Set)
+	}},
+}
+
+// UContextLaws returns the invariants of the arithmetic of given
+// context whose properties take [ints.UDecimal] arguments.  The laws
+// about overflows account for a context's overflow policy, i.e. in a
+// saturating context an overflow is a result clamped to its bound.
+func UContextLaws(c *ints.UContext) []Law {
+	one, saturates := one(c), c.Overflow() == ints.OverflowSaturate
+	return []Law{
+		{"Add is commutative", func(a, b ints.UDecimal) bool {
+			s1, err1 := c.Add(a, b)
+			s2, err2 := c.Add(b, a)
+			return s1 == s2 && (err1 == nil) == (err2 == nil)
+		}},
+		{"Add is associative unless overflow", func(a, b, d ints.UDecimal) bool {
+			ab, err := c.Add(a, b)
+			if err != nil {
+				return true
+			}
+			abd, err := c.Add(ab, d)
+			if err != nil {
+				return true
+			}
+			bd, err := c.Add(b, d)
+			if err != nil {
+				return false // b+d <= a+b+d
+			}
+			s, err := c.Add(a, bd)
+			return err == nil && s == abd
+		}},
+		{"Add then Sub is identity unless overflow", func(a, b ints.UDecimal) bool {
+			s, err := c.Add(a, b)
+			if err != nil || (saturates && s == c.Max) {
+				return a > c.Max-b || (saturates && a == c.Max-b)
+			}
+			d, err := c.Sub(s, b)
+			return err == nil && d == a
+		}},
+		{"Sub overflows iff subtrahend is greater", func(a, b ints.UDecimal) bool {
+			d, err := c.Sub(a, b)
+			if saturates {
+				return err == nil && (d == 0) == (b >= a)
+			}
+			return (err != nil) == (b > a)
+		}},
+		{"Zero is additive identity", func(a ints.UDecimal) bool {
+			s, err := c.Add(a, 0)
+			return err == nil && s == a
+		}},
+		{"One is multiplicative identity", func(a ints.UDecimal) bool {
+			p1, err1 := c.Mult(a, one)
+			p2, err2 := c.Mult(one, a)
+			return err1 == nil && err2 == nil && p1 == a && p2 == a
+		}},
+		{"Mult is commutative unless overflow", func(a, b ints.UDecimal) bool {
+			p1, err1 := c.Mult(a, b)
+			p2, err2 := c.Mult(b, a)
+			return err1 != nil || err2 != nil || p1 == p2
+		}},
+		{"Mult by zero is zero", func(a ints.UDecimal) bool {
+			p, err := c.Mult(a, 0)
+			return err == nil && p == 0
+		}},
+		{"Div by one is identity", func(a ints.UDecimal) bool {
+			q, err := c.Div(a, one)
+			return err == nil && q == a
+		}},
+		{"Div by zero fails", func(a ints.UDecimal) bool {
+			_, err := c.Div(a, 0)
+			return err == ints.ErrDividedByZero
+		}},
+		{"Results never exceed Max", func(a, b ints.UDecimal) bool {
+			for _, op := range []func(a, b ints.UDecimal) (
+				ints.UDecimal, error){c.Add, c.Sub, c.Mult, c.Div} {
+				if r, err := op(a, b); err == nil && r > c.Max {
+					return false
+				}
+			}
+			return true
+		}},
+	}
+}
+
+// clone returns a copy of given set.
+func clone(s *ints.Set) *ints.Set { return ints.FromWords(s.Words()) }
+
+// union returns a new set with the elements of given sets.
+func union(a, b *ints.Set) *ints.Set {
+	return combine(a, b, func(x, y uint64) uint64 { return x | y })
+}
+
+// intersect returns a new set with the elements which are in both given
+// sets.
+func intersect(a, b *ints.Set) *ints.Set {
+	return combine(a, b, func(x, y uint64) uint64 { return x & y })
+}
+
+// diff returns a new set with the elements of a which are not in b.
+func diff(a, b *ints.Set) *ints.Set {
+	return combine(a, b, func(x, y uint64) uint64 { return x &^ y })
+}
+
+// combine returns the set whose words are the results of given
+// operation on the corresponding words of given sets.
+func combine(a, b *ints.Set, op func(x, y uint64) uint64) *ints.Set {
+	aa, bb := a.Words(), b.Words()
+	n := len(aa)
+	if len(bb) > n {
+		n = len(bb)
+	}
+	ww := make([]uint64, n)
+	for i := range ww {
+		var x, y uint64
+		if i < len(aa) {
+			x = aa[i]
+		}
+		if i < len(bb) {
+			y = bb[i]
+		}
+		ww[i] = op(x, y)
+	}
+	return ints.FromWords(ww)
+}
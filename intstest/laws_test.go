@@ -0,0 +1,88 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package intstest
+
+import (
+	"math/rand"
+	"testing"
+	"testing/quick"
+
+	. "github.com/slukits/gounit"
+	"github.com/slukits/ints"
+)
+
+type laws struct{ Suite }
+
+func (s *laws) SetUp(t *T) { t.Parallel() }
+
+func (s *laws) Hold_for_sets(t *T) {
+	t.FatalOn(CheckSetLaws(&quick.Config{MaxCount: 200}))
+}
+
+func (s *laws) Hold_for_contexts(t *T) {
+	for _, c := range []*ints.UContext{
+		ints.UDec,
+		ints.UDec.New(ints.ONE_FRACTIONAL, ints.DEFAULTS),
+		ints.UDec.New(ints.TWO_FRACTIONALS, ints.DEFAULTS),
+		ints.UDec.New(ints.EIGHT_FRACTIONALS, ints.DEFAULTS),
+		ints.MNewContext(ints.OnOverflow(ints.OverflowSaturate)),
+		ints.MNewContext(ints.Fractionals(2),
+			ints.OnOverflow(ints.OverflowSaturate)),
+	} {
+		t.FatalOn(CheckUContextLaws(c, &quick.Config{MaxCount: 2000}))
+	}
+}
+
+func (s *laws) Report_violated_law(t *T) {
+	err := Check([]Law{{"never", func(a Set) bool { return false }}}, nil)
+	t.ErrMatched(err, `law "never"`)
+}
+
+func (s *laws) Generate_sets_of_each_shape(t *T) {
+	rng := rand.New(rand.NewSource(1))
+	sp := Sparse{}.Generate(rng, 50).Interface().(Sparse)
+	t.True(sp.Len() < 20)
+	dn := Dense{}.Generate(rng, 50).Interface().(Dense)
+	ee := dn.ToSlice()
+	t.True(len(ee) == 0 || ee[len(ee)-1]-ee[0] < 2*len(ee))
+	eow := EdgeOfWord{}.Generate(rng, 50).Interface().(EdgeOfWord)
+	eow.For(func(e int) { t.True(e%32 == 31 || e%32 <= 1) })
+}
+
+func (s *laws) Generate_decimals_up_to_max(t *T) {
+	rng, c := rand.New(rand.NewSource(1)), ints.UDec.New(
+		ints.TWO_FRACTIONALS, ints.DEFAULTS)
+	hasMax := false
+	for i := 0; i < 1000; i++ {
+		d := Decimal(c, rng)
+		t.True(d <= c.Max)
+		hasMax = hasMax || d == c.Max
+	}
+	t.True(hasMax)
+}
+
+func (s *laws) Provide_seed_corpora(t *T) {
+	for _, seed := range SetSeeds() {
+		t.Eq(seed, bitmap(SetFromBytes(seed))[:len(seed)])
+	}
+	t.Eq(uint64(ints.UDec.Max), DecimalSeeds(ints.UDec)[11])
+}
+
+func TestLaws(t *testing.T) {
+	t.Parallel()
+	Run(&laws{}, t)
+}
+
+func FuzzSetLaws(f *testing.F) {
+	for _, seed := range SetSeeds() {
+		f.Add(seed, seed)
+	}
+	f.Fuzz(func(t *testing.T, a, b []byte) {
+		sa, sb := SetFromBytes(a), SetFromBytes(b)
+		if !union(sa, sb).Eq(union(sb, sa)) {
+			t.Errorf("union of %v and %v is not commutative", sa, sb)
+		}
+	})
+}
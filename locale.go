@@ -0,0 +1,119 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package ints
+
+import (
+	"errors"
+	"sort"
+)
+
+// ErrLocale is returned by [LookupLocale] for an unknown locale tag.
+var ErrLocale = errors.New("ints: dec: unknown locale")
+
+// A Locale defines the decimal mark and the digit grouping of a
+// decimal's localized string representation; see [LocaleFormatter].
+type Locale struct {
+
+	// Tag is the locale's BCP 47 language tag, e.g. "de-CH".
+	Tag string
+
+	// Decimal is the decimal mark separating integrals and fractionals.
+	Decimal rune
+
+	// Grouping separates the groups of integral digits.
+	Grouping rune
+
+	// Primary is the number of digits of the rightmost group of
+	// integrals while Secondary is the number of digits of all other
+	// groups, e.g. 3 and 2 for the Indian "12,34,567".
+	Primary, Secondary int
+}
+
+// locales is the embedded table of supported locales.
+var locales = map[string]Locale{
+	"en-US": {Tag: "en-US", Decimal: '.', Grouping: ',',
+		Primary: 3, Secondary: 3},
+	"de-DE": {Tag: "de-DE", Decimal: ',', Grouping: '.',
+		Primary: 3, Secondary: 3},
+	"de-CH": {Tag: "de-CH", Decimal: '.', Grouping: '\'',
+		Primary: 3, Secondary: 3},
+	"fr-FR": {Tag: "fr-FR", Decimal: ',', Grouping: '\u202F',
+		Primary: 3, Secondary: 3},
+	"en-IN": {Tag: "en-IN", Decimal: '.', Grouping: ',',
+		Primary: 3, Secondary: 2},
+}
+
+// LookupLocale returns the locale of given tag, i.e. one of "en-US",
+// "de-DE", "de-CH" (apostrophe grouping), "fr-FR" (narrow no-break
+// space grouping) or "en-IN" (lakh grouping).  It fails with an
+// [ErrLocale] if the tag is unknown.
+func LookupLocale(tag string) (Locale, error) {
+	l, ok := locales[tag]
+	if !ok {
+		return Locale{}, ErrLocale
+	}
+	return l, nil
+}
+
+// MLookupLocale is the 'Must'-variant of [LookupLocale] which panics if
+// corresponding LookupLocale-call fails.
+func MLookupLocale(tag string) Locale {
+	l, err := LookupLocale(tag)
+	if err != nil {
+		panic(err)
+	}
+	return l
+}
+
+// Locales returns the sorted tags of the supported locales.
+func Locales() []string {
+	tt := make([]string, 0, len(locales))
+	for t := range locales {
+		tt = append(tt, t)
+	}
+	sort.Strings(tt)
+	return tt
+}
+
+// A LocaleFormatter provides a decimal's string representation using a
+// locale's decimal mark and digit grouping.  The number of fractionals
+// is taken from a context's format flags as [UDecimal.Str] does while
+// the context's separators are ignored.  Prefix and Suffix are literal
+// texts written before respectively after the number, e.g. a currency:
+//
+//	f := ints.LocaleFormatter{
+//	    Locale: ints.MLookupLocale("fr-FR"), Suffix: "\u00a0€"}
+//	f.Str(ints.UDec.From.MStr("1234567.5"), ints.UDec)
+//	// "1 234 567,50 €" with narrow no-break spaces
+type LocaleFormatter struct {
+	Locale
+	Prefix, Suffix string
+}
+
+// Str returns the localized string representation of given decimal
+// whose fractionals are truncated or padded according to given
+// context's format flags; see [UDecimal.Str].
+func (f LocaleFormatter) Str(d UDecimal, c *UContext) string {
+	return f.format(d.truncated(c))
+}
+
+// Rnd returns the localized string representation of given decimal
+// whose fractionals are rounded to even according to given context's
+// format flags; see [UDecimal.Rnd].
+func (f LocaleFormatter) Rnd(d UDecimal, c *UContext) string {
+	return f.format(d.rounded(c))
+}
+
+func (f LocaleFormatter) format(dd fmtDigits) string {
+	primary, secondary := f.Primary, f.Secondary
+	if primary <= 0 {
+		primary = 3
+	}
+	if secondary <= 0 {
+		secondary = primary
+	}
+	return f.Prefix + dd.format(f.Decimal, f.Grouping, primary,
+		secondary) + f.Suffix
+}
@@ -0,0 +1,89 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package ints
+
+import (
+	"testing"
+
+	. "github.com/slukits/gounit"
+)
+
+type locale struct{ Suite }
+
+func (s *locale) SetUp(t *T) { t.Parallel() }
+
+func (s *locale) Formats_with_locales_marks_and_grouping(t *T) {
+	d := UDec.From.MStr("1234567.891")
+	for tag, exp := range map[string]string{
+		"en-US": "1,234,567.89",
+		"de-DE": "1.234.567,89",
+		"de-CH": "1'234'567.89",
+		"fr-FR": "1\u202f234\u202f567,89",
+		"en-IN": "12,34,567.89",
+	} {
+		f := LocaleFormatter{Locale: MLookupLocale(tag)}
+		t.Eq(exp, f.Str(d, UDec))
+	}
+}
+
+func (s *locale) Groups_lakh_and_crore(t *T) {
+	f := LocaleFormatter{Locale: MLookupLocale("en-IN")}
+	for in, exp := range map[string]string{
+		"1": "1.00", "123": "123.00", "1234": "1,234.00",
+		"123456": "1,23,456.00", "12345678": "1,23,45,678.00",
+	} {
+		t.Eq(exp, f.Str(UDec.From.MStr(in), UDec))
+	}
+}
+
+func (s *locale) Rounds_to_even(t *T) {
+	f := LocaleFormatter{Locale: MLookupLocale("de-DE")}
+	t.Eq("1.000,00", f.Rnd(UDec.From.MStr("999.995"), UDec))
+	t.Eq("999,99", f.Str(UDec.From.MStr("999.995"), UDec))
+	t.Eq("2,24", f.Rnd(UDec.From.MStr("2.245"), UDec))
+}
+
+func (s *locale) Uses_format_fractionals_of_context(t *T) {
+	f := LocaleFormatter{Locale: MLookupLocale("en-US")}
+	dec := UDec.New(DEFAULTS, ZERO_FRACTIONALS)
+	t.Eq("1,235", f.Rnd(dec.From.MStr("1234.5001"), dec))
+	t.Eq("1,234", f.Str(dec.From.MStr("1234.5001"), dec))
+	dec.SetFmt(EIGHT_FRACTIONALS)
+	t.Eq("1,234.50010000", f.Str(dec.From.MStr("1234.5001"), dec))
+}
+
+func (s *locale) Adds_leading_and_trailing_literals(t *T) {
+	chf := LocaleFormatter{Locale: MLookupLocale("de-CH"),
+		Prefix: "CHF "}
+	t.Eq("CHF 12'500.05", chf.Str(UDec.From.MStr("12500.05"), UDec))
+	eur := LocaleFormatter{Locale: MLookupLocale("fr-FR"),
+		Suffix: "\u00a0€"}
+	t.Eq("1\u202f000,00\u00a0€", eur.Str(UDec.From.MStr("1000"), UDec))
+}
+
+func (s *locale) Ignores_contexts_separators(t *T) {
+	dec := MNewContext(Separator('·'), Grouping(' '))
+	f := LocaleFormatter{Locale: MLookupLocale("en-US")}
+	t.Eq("1,234.50", f.Str(dec.From.MStr("1234·5"), dec))
+}
+
+func (s *locale) Defaults_group_sizes_of_custom_locale(t *T) {
+	f := LocaleFormatter{Locale: Locale{Decimal: ',', Grouping: '_'}}
+	t.Eq("1_234_567,00", f.Str(UDec.From.MStr("1234567"), UDec))
+	f.Primary = 4
+	t.Eq("123_4567,00", f.Str(UDec.From.MStr("1234567"), UDec))
+}
+
+func (s *locale) Fails_looking_up_unknown_tag(t *T) {
+	_, err := LookupLocale("xx-XX")
+	t.ErrIs(err, ErrLocale)
+	t.Panics(func() { MLookupLocale("de") })
+	t.Eq([]string{"de-CH", "de-DE", "en-IN", "en-US", "fr-FR"}, Locales())
+}
+
+func TestLocale(t *testing.T) {
+	t.Parallel()
+	Run(&locale{}, t)
+}
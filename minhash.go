@@ -0,0 +1,198 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package ints
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+)
+
+// ErrSignature is returned by an [LSHIndex] for a signature which is
+// shorter than the index's bands times rows.
+var ErrSignature = errors.New("ints: minhash: index: signature too short")
+
+// ErrSignatureLength is returned by [NewMinHasher] for a signature
+// length smaller than one.
+var ErrSignatureLength = errors.New(
+	"ints: minhash: signature length must be positive")
+
+// Signature is the MinHash signature of a [Set] calculated by a
+// [MinHasher], i.e. its i-th value is the minimum of the i-th hash
+// function over the set's elements.
+type Signature []uint64
+
+// MinHasher calculates k-permutation MinHash signatures of sets whose
+// similarity estimates their Jaccard similarity.  Signatures are only
+// comparable if they were calculated by MinHashers with the same k and
+// seed.
+type MinHasher struct {
+	seeds []uint64
+}
+
+// NewMinHasher returns a MinHasher calculating signatures of given
+// length k whose hash functions are derived from given seed.  It fails
+// with an [ErrSignatureLength] if k is smaller than one.
+func NewMinHasher(k int, seed int64) (*MinHasher, error) {
+	if k < 1 {
+		return nil, ErrSignatureLength
+	}
+	rng, h := rand.New(rand.NewSource(seed)), &MinHasher{
+		seeds: make([]uint64, k)}
+	for i := range h.seeds {
+		h.seeds[i] = rng.Uint64()
+	}
+	return h, nil
+}
+
+// MNewMinHasher is the "must"-variant of [NewMinHasher] which panics if
+// corresponding NewMinHasher-call fails.
+func MNewMinHasher(k int, seed int64) *MinHasher {
+	h, err := NewMinHasher(k, seed)
+	if err != nil {
+		panic(err)
+	}
+	return h
+}
+
+// K returns the length of the signatures calculated by receiving
+// MinHasher.
+func (h *MinHasher) K() int { return len(h.seeds) }
+
+// Signature returns the MinHash signature of given set.  The signature
+// of the empty set has only maximal values.
+func (h *MinHasher) Signature(s *Set) Signature {
+	sig := make(Signature, len(h.seeds))
+	for i := range sig {
+		sig[i] = math.MaxUint64
+	}
+	s.For(func(elm int) {
+		for i, seed := range h.seeds {
+			if v := mix64(uint64(elm) ^ seed); v < sig[i] {
+				sig[i] = v
+			}
+		}
+	})
+	return sig
+}
+
+// mix64 is the splitmix64 finalizer.
+func mix64(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	return x ^ x>>31
+}
+
+// Similarity estimates the Jaccard similarity of the sets of given
+// signatures, i.e. it returns the fraction of equal signature values.
+// Signatures of different length have a similarity of zero.
+func (sig Signature) Similarity(other Signature) float64 {
+	if len(sig) != len(other) || len(sig) == 0 {
+		return 0
+	}
+	eq := 0
+	for i, v := range sig {
+		if other[i] == v {
+			eq++
+		}
+	}
+	return float64(eq) / float64(len(sig))
+}
+
+// Jaccard returns the exact Jaccard similarity of receiving and given
+// set, i.e. the cardinality of their intersection divided by the
+// cardinality of their union.  Two empty sets have a similarity of one.
+func (s *Set) Jaccard(other *Set) float64 {
+	if s.IsEmpty() && other.IsEmpty() {
+		return 1
+	}
+	both := 0
+	s.For(func(elm int) {
+		if other.has(elm) {
+			both++
+		}
+	})
+	return float64(both) / float64(s.Len()+other.Len()-both)
+}
+
+// LSHIndex is a locality sensitive hashing index of MinHash signatures
+// which splits a signature into bands of rows.  Sets with a Jaccard
+// similarity s have at least one equal band, i.e. are found by a
+// query, with probability 1-(1-s^rows)^bands.  Create an index by
+// [MinHasher.NewIndex].
+type LSHIndex struct {
+	rows    int
+	buckets []map[uint64]*Set
+}
+
+// NewIndex returns an LSH index for receiving MinHasher's signatures
+// with given number of bands each having K/bands rows.  Is bands
+// smaller than one or greater than K it is set to K.
+func (h *MinHasher) NewIndex(bands int) *LSHIndex {
+	if bands < 1 || bands > h.K() {
+		bands = h.K()
+	}
+	idx := &LSHIndex{rows: h.K() / bands,
+		buckets: make([]map[uint64]*Set, bands)}
+	for i := range idx.buckets {
+		idx.buckets[i] = map[uint64]*Set{}
+	}
+	return idx
+}
+
+// band returns the hash of given signature's b-th band.
+func (idx *LSHIndex) band(sig Signature, b int) uint64 {
+	hash := uint64(b)
+	for _, v := range sig[b*idx.rows : (b+1)*idx.rows] {
+		hash = mix64(hash ^ v)
+	}
+	return hash
+}
+
+// Add adds given signature with given non-negative id to receiving
+// index.  It fails with an [ErrSignature] if given signature is shorter
+// than the index's bands times rows.
+func (idx *LSHIndex) Add(id int, sig Signature) error {
+	if err := idx.valid(sig); err != nil {
+		return err
+	}
+	for b, bucket := range idx.buckets {
+		key := idx.band(sig, b)
+		if bucket[key] == nil {
+			bucket[key] = &Set{}
+		}
+		bucket[key].add(id)
+	}
+	return nil
+}
+
+// Query returns the ids of the added signatures sharing at least one
+// band with given signature, i.e. the candidates for near duplicates
+// whose similarity may be verified by [Signature.Similarity] or
+// [Set.Jaccard].  It fails with an [ErrSignature] if given signature
+// is shorter than the index's bands times rows.
+func (idx *LSHIndex) Query(sig Signature) (*Set, error) {
+	if err := idx.valid(sig); err != nil {
+		return nil, err
+	}
+	ids := &Set{}
+	for b, bucket := range idx.buckets {
+		if cc, ok := bucket[idx.band(sig, b)]; ok {
+			cc.For(ids.add)
+		}
+	}
+	return ids, nil
+}
+
+// valid fails if given signature has less than bands times rows
+// values.
+func (idx *LSHIndex) valid(sig Signature) error {
+	if len(sig) < len(idx.buckets)*idx.rows {
+		return ErrSignature
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package ints
+
+import (
+	"math"
+	"testing"
+
+	. "github.com/slukits/gounit"
+)
+
+type minHash struct{ Suite }
+
+func (s *minHash) SetUp(t *T) { t.Parallel() }
+
+func rangeSet(lo, hi int) *Set {
+	s := &Set{}
+	for i := lo; i < hi; i++ {
+		s.add(i)
+	}
+	return s
+}
+
+func (s *minHash) Exact_jaccard_similarity(t *T) {
+	t.Eq(1.0, (&Set{}).Jaccard(&Set{}))
+	t.Eq(0.5, FromSlice([]int{1, 2, 3}).Jaccard(FromSlice([]int{2, 3, 4})))
+	t.Eq(0.0, FromSlice([]int{1}).Jaccard(FromSlice([]int{2})))
+}
+
+func (s *minHash) Signatures_are_reproducible_from_seed(t *T) {
+	st := rangeSet(0, 100)
+	t.Eq(MNewMinHasher(64, 42).Signature(st),
+		MNewMinHasher(64, 42).Signature(st))
+	t.Not.Eq(MNewMinHasher(64, 42).Signature(st),
+		MNewMinHasher(64, 43).Signature(st))
+}
+
+func (s *minHash) Similarity_estimates_jaccard_similarity(t *T) {
+	h := MNewMinHasher(512, 1)
+	for _, b := range []*Set{rangeSet(0, 1000), rangeSet(200, 1200),
+		rangeSet(500, 1500), rangeSet(2000, 3000)} {
+		a := rangeSet(0, 1000)
+		est := h.Signature(a).Similarity(h.Signature(b))
+		t.True(math.Abs(est-a.Jaccard(b)) < 0.07)
+	}
+	t.Eq(0.0, h.Signature(rangeSet(0, 3)).Similarity(Signature{}))
+}
+
+func (s *minHash) Index_finds_near_duplicates(t *T) {
+	h := MNewMinHasher(128, 7)
+	idx := h.NewIndex(32)
+	for id, st := range []*Set{rangeSet(0, 1000), rangeSet(30, 1030),
+		rangeSet(5000, 6000), rangeSet(900, 1900)} {
+		t.FatalOn(idx.Add(id, h.Signature(st)))
+	}
+	got, err := idx.Query(h.Signature(rangeSet(10, 1010)))
+	t.FatalOn(err)
+	t.True(got.Has(0, 1))
+	t.Not.True(got.Has(2))
+	got, err = idx.Query(h.Signature(rangeSet(8000, 9000)))
+	t.FatalOn(err)
+	t.True(got.IsEmpty())
+}
+
+func (s *minHash) Index_fails_for_short_signatures(t *T) {
+	idx := MNewMinHasher(128, 7).NewIndex(32)
+	short := MNewMinHasher(100, 7).Signature(rangeSet(0, 10))
+	t.ErrIs(idx.Add(0, short), ErrSignature)
+	_, err := idx.Query(short)
+	t.ErrIs(err, ErrSignature)
+	_, err = idx.Query(nil)
+	t.ErrIs(err, ErrSignature)
+}
+
+func (s *minHash) Hasher_fails_for_non_positive_signature_length(t *T) {
+	for _, k := range []int{0, -1} {
+		_, err := NewMinHasher(k, 7)
+		t.ErrIs(err, ErrSignatureLength)
+	}
+	t.Panics(func() { MNewMinHasher(0, 7) })
+	t.Eq(1, MNewMinHasher(1, 7).NewIndex(1).rows)
+}
+
+func TestMinHash(t *testing.T) {
+	t.Parallel()
+	Run(&minHash{}, t)
+}
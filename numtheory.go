@@ -0,0 +1,198 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package ints
+
+import (
+	"math"
+	"math/big"
+	"math/bits"
+)
+
+// sieveSegment is the number of odd integers sieved at once by Primes.
+const sieveSegment = 1 << 15
+
+// Primes returns the set of primes smaller or equal to given n.  The
+// primes are calculated by a segmented sieve of Eratosthenes whose
+// segments represent only odd numbers packed into words.
+func Primes(n int) *Set {
+	pp := &Set{}
+	if n < 2 {
+		return pp
+	}
+	pp.add(2)
+	base := oddPrimes(isqrt(n))
+	seg := make([]uint64, sieveSegment/64)
+	for lo := 3; lo <= n; lo += 2 * sieveSegment {
+		for i := range seg {
+			seg[i] = 0
+		}
+		hi := lo + 2*sieveSegment // exclusive
+		for _, p := range base {
+			m := p * p
+			if m >= hi {
+				break
+			}
+			if m < lo {
+				if m = (lo + p - 1) / p * p; m%2 == 0 {
+					m += p
+				}
+			}
+			for ; m < hi; m += 2 * p {
+				idx := (m - lo) / 2
+				seg[idx/64] |= 1 << (idx % 64)
+			}
+		}
+		for i, w := range seg {
+			for w = ^w; w != 0; w &= w - 1 {
+				p := lo + 2*(i*64+bits.TrailingZeros64(w))
+				if p > n {
+					return pp
+				}
+				pp.add(p)
+			}
+		}
+	}
+	return pp
+}
+
+// oddPrimes returns the odd primes smaller or equal to given n.
+func oddPrimes(n int) (pp []int) {
+	composite := make([]bool, n+1)
+	for i := 3; i <= n; i += 2 {
+		if composite[i] {
+			continue
+		}
+		pp = append(pp, i)
+		for m := i * i; m <= n; m += 2 * i {
+			composite[m] = true
+		}
+	}
+	return pp
+}
+
+// isqrt returns the greatest integer whose square is smaller or equal
+// to given non-negative n.
+func isqrt(n int) int {
+	r := int(math.Sqrt(float64(n)))
+	for r > 0 && r > n/r {
+		r--
+	}
+	for r+1 <= n/(r+1) {
+		r++
+	}
+	return r
+}
+
+// IsPrime returns true if given n is a prime.  Small n are checked by
+// trial division, bigger ones by the Baillie-PSW test which is exact
+// for all integers representable by an int.
+func IsPrime(n int) bool {
+	if n < 2 {
+		return false
+	}
+	if n < 1<<16 {
+		if n%2 == 0 {
+			return n == 2
+		}
+		for i := 3; i*i <= n; i += 2 {
+			if n%i == 0 {
+				return false
+			}
+		}
+		return true
+	}
+	return big.NewInt(int64(n)).ProbablyPrime(0)
+}
+
+// Factorize returns the ascending prime factors of given n whereas a
+// factor is repeated according to its multiplicity, e.g. 12 returns
+// [2 2 3].  n smaller than two has no prime factors.
+func Factorize(n int) (ff []int) {
+	if n < 2 {
+		return nil
+	}
+	for n%2 == 0 {
+		ff = append(ff, 2)
+		n /= 2
+	}
+	prime := IsPrime(n) // spares the trial divisions of a big prime rest
+	for i := 3; i <= n/i && !prime; i += 2 {
+		if n%i != 0 {
+			continue
+		}
+		for n%i == 0 {
+			ff = append(ff, i)
+			n /= i
+		}
+		prime = IsPrime(n)
+	}
+	if n > 1 {
+		ff = append(ff, n)
+	}
+	return ff
+}
+
+// Divisors returns the set of positive divisors of given positive n.
+// Note that a set's memory usage grows with its greatest element, i.e.
+// n should be small.
+func Divisors(n int) *Set {
+	dd := &Set{}
+	if n < 1 {
+		return dd
+	}
+	divisors := []int{1}
+	ff := Factorize(n)
+	for i := 0; i < len(ff); {
+		p, pow, j := ff[i], 1, i
+		cnt := len(divisors)
+		for ; j < len(ff) && ff[j] == p; j++ {
+			pow *= p
+			for _, d := range divisors[:cnt] {
+				divisors = append(divisors, d*pow)
+			}
+		}
+		i = j
+	}
+	return dd.Add(divisors...)
+}
+
+// Coprimes returns the set of integers from 1 to given n which are
+// coprime to n.  Note that a set's memory usage grows with its greatest
+// element, i.e. n should be small.
+func Coprimes(n int) *Set {
+	cc := &Set{}
+	if n < 1 {
+		return cc
+	}
+	for i := 1; i <= n; i++ {
+		cc.add(i)
+	}
+	if n == 1 {
+		return cc
+	}
+	for _, p := range FromSlice(Factorize(n)).ToSlice() {
+		for m := p; m <= n; m += p {
+			cc.del(m)
+		}
+	}
+	return cc
+}
+
+// Totient returns Euler's totient of given n, i.e. the number of
+// integers from 1 to n which are coprime to n.  n smaller than one has
+// a totient of zero.
+func Totient(n int) int {
+	if n < 1 {
+		return 0
+	}
+	t, last := n, 0
+	for _, p := range Factorize(n) {
+		if p == last {
+			continue
+		}
+		t, last = t/p*(p-1), p
+	}
+	return t
+}
@@ -0,0 +1,81 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package ints
+
+import (
+	"math"
+	"testing"
+
+	. "github.com/slukits/gounit"
+)
+
+type numTheory struct{ Suite }
+
+func (s *numTheory) SetUp(t *T) { t.Parallel() }
+
+func (s *numTheory) Primes_up_to_n(t *T) {
+	t.Eq("{}", Primes(1).String())
+	t.Eq("{2}", Primes(2).String())
+	t.Eq("{2, 3, 5, 7, 11, 13, 17, 19, 23, 29}", Primes(29).String())
+	t.Eq("{2, 3, 5, 7, 11, 13, 17, 19, 23}", Primes(28).String())
+}
+
+func (s *numTheory) Integer_square_root_does_not_overflow(t *T) {
+	for n, exp := range map[int]int{0: 0, 1: 1, 3: 1, 4: 2, 99: 9,
+		math.MaxInt32: 46340} {
+		t.Eq(exp, isqrt(n))
+	}
+	r := isqrt(math.MaxInt)
+	t.True(r <= math.MaxInt/r && r+1 > math.MaxInt/(r+1))
+}
+
+func (s *numTheory) Primes_match_trial_division_across_segments(t *T) {
+	n := 3*2*sieveSegment + 17
+	pp := Primes(n)
+	for i := 0; i <= n; i++ {
+		if pp.Has(i) != IsPrime(i) {
+			t.Fatalf("sieve and trial division disagree on %d", i)
+		}
+	}
+	t.Eq(17705, pp.Len())
+}
+
+func (s *numTheory) Is_prime(t *T) {
+	for _, p := range []int{2, 3, 65537, 1000000007, 2147483647} {
+		t.True(IsPrime(p))
+	}
+	for _, c := range []int{-7, 0, 1, 4, 65535, 1000000007 * 2,
+		65537 * 32749} {
+		t.Not.True(IsPrime(c))
+	}
+}
+
+func (s *numTheory) Factorizes_into_ascending_primes(t *T) {
+	t.Eq([]int{2, 2, 3}, Factorize(12))
+	t.Eq([]int{2, 3, 3, 7, 11, 31, 151, 331}, Factorize(2147483646))
+	t.Eq([]int{1000000007}, Factorize(1000000007))
+	t.Eq(0, len(Factorize(1)))
+}
+
+func (s *numTheory) Divisors_of_n(t *T) {
+	t.Eq("{1, 2, 3, 4, 6, 12}", Divisors(12).String())
+	t.Eq("{1}", Divisors(1).String())
+	t.Eq("{1, 13}", Divisors(13).String())
+	t.True(Divisors(0).IsEmpty())
+}
+
+func (s *numTheory) Coprimes_and_totient_of_n(t *T) {
+	t.Eq("{1, 5, 7, 11}", Coprimes(12).String())
+	t.Eq("{1}", Coprimes(1).String())
+	for _, n := range []int{1, 2, 12, 36, 97, 100} {
+		t.Eq(Coprimes(n).Len(), Totient(n))
+	}
+	t.Eq(0, Totient(0))
+}
+
+func TestNumTheory(t *testing.T) {
+	t.Parallel()
+	Run(&numTheory{}, t)
+}
@@ -0,0 +1,215 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package ints
+
+// SetChange is the notification of an [ObservableSet]'s subscribers
+// about the elements which were added to and removed from the set.
+type SetChange struct {
+	Added, Removed *Set
+}
+
+// ObservableSet wraps a [Set] and notifies its subscribers about
+// elements which are added or removed.  Changes made during a
+// [ObservableSet.Batch] are coalesced into a single notification.
+// Derived sets like [ObservableSet.Union] or [ObservableSet.Filter] are
+// observable sets which are kept up to date incrementally as their
+// sources change.  An ObservableSet is not safe for concurrent use.
+// The zero value is ready to use.
+type ObservableSet struct {
+	set            Set
+	subscribers    []func(SetChange)
+	batch          int
+	added, removed Set
+}
+
+// Subscribe registers given callback which is called with the changes
+// of receiving set.  The returned function cancels the subscription.
+func (o *ObservableSet) Subscribe(cb func(SetChange)) (cancel func()) {
+	o.subscribers = append(o.subscribers, cb)
+	idx := len(o.subscribers) - 1
+	return func() { o.subscribers[idx] = nil }
+}
+
+// Batch calls given function and notifies the subscribers once about
+// the net changes which were made during the call, e.g. adding and
+// removing the same element during a batch is not reported.  Batches
+// may be nested.
+func (o *ObservableSet) Batch(changes func()) {
+	o.batch++
+	defer func() {
+		if o.batch--; o.batch == 0 {
+			o.notify()
+		}
+	}()
+	changes()
+}
+
+func (o *ObservableSet) notify() {
+	if o.added.IsEmpty() && o.removed.IsEmpty() {
+		return
+	}
+	chg := SetChange{Added: o.added.copy(), Removed: o.removed.copy()}
+	o.added, o.removed = Set{}, Set{}
+	for _, cb := range o.subscribers {
+		if cb != nil {
+			cb(chg)
+		}
+	}
+}
+
+// Add adds given integers to receiving set notifying the subscribers
+// about the new elements.
+func (o *ObservableSet) Add(elms ...int) *ObservableSet {
+	o.Batch(func() {
+		for _, elm := range elms {
+			if elm < 0 || o.set.has(elm) {
+				continue
+			}
+			o.set.add(elm)
+			if o.removed.has(elm) {
+				o.removed.del(elm)
+				continue
+			}
+			o.added.add(elm)
+		}
+	})
+	return o
+}
+
+// Del removes given elements from receiving set notifying the
+// subscribers about the removed elements.
+func (o *ObservableSet) Del(elm int, elms ...int) *ObservableSet {
+	o.Batch(func() {
+		for _, elm := range append([]int{elm}, elms...) {
+			if !o.set.has(elm) {
+				continue
+			}
+			o.set.del(elm)
+			if o.added.has(elm) {
+				o.added.del(elm)
+				continue
+			}
+			o.removed.add(elm)
+		}
+	})
+	return o
+}
+
+// Len returns the set's cardinality.
+func (o *ObservableSet) Len() int { return o.set.Len() }
+
+// IsEmpty returns true if the set's cardinality is zero.
+func (o *ObservableSet) IsEmpty() bool { return o.set.IsEmpty() }
+
+// Has returns true if given integers are in receiving set; false
+// otherwise.
+func (o *ObservableSet) Has(elm int, elms ...int) bool {
+	return o.set.Has(elm, elms...)
+}
+
+// For calls back for each element e providing e.
+func (o *ObservableSet) For(elm func(int)) { o.set.For(elm) }
+
+// ToSet returns a copy of the observed set.
+func (o *ObservableSet) ToSet() *Set { return o.set.copy() }
+
+// String returns the observed set's string representation.
+func (o *ObservableSet) String() string { return o.set.String() }
+
+// derive returns a new observable set initialized with given set which
+// is updated by given function for each change of given sources.  A
+// source's change is applied in a batch, i.e. is reported at most once
+// by the derived set.
+func derive(
+	init *Set, update func(*ObservableSet, SetChange, int),
+	sources ...*ObservableSet,
+) *ObservableSet {
+	d := &ObservableSet{set: *init}
+	for i, src := range sources {
+		i := i
+		src.Subscribe(func(chg SetChange) {
+			d.Batch(func() { update(d, chg, i) })
+		})
+	}
+	return d
+}
+
+// Union returns an observable set which is the union of receiving and
+// given other set as they change.  It should not be modified directly.
+func (o *ObservableSet) Union(other *ObservableSet) *ObservableSet {
+	srcs := [2]*ObservableSet{o, other}
+	return derive(o.set.union(&other.set), func(
+		d *ObservableSet, chg SetChange, src int,
+	) {
+		d.Add(chg.Added.ToSlice()...)
+		chg.Removed.For(func(elm int) {
+			if !srcs[1-src].set.has(elm) {
+				d.Del(elm)
+			}
+		})
+	}, o, other)
+}
+
+// Intersect returns an observable set which is the intersection of
+// receiving and given other set as they change.  It should not be
+// modified directly.
+func (o *ObservableSet) Intersect(other *ObservableSet) *ObservableSet {
+	srcs := [2]*ObservableSet{o, other}
+	return derive(o.set.intersect(&other.set), func(
+		d *ObservableSet, chg SetChange, src int,
+	) {
+		chg.Added.For(func(elm int) {
+			if srcs[1-src].set.has(elm) {
+				d.Add(elm)
+			}
+		})
+		chg.Removed.For(func(elm int) { d.Del(elm) })
+	}, o, other)
+}
+
+// Diff returns an observable set which has the elements of receiving
+// set which are not in given other set as they change.  It should not
+// be modified directly.
+func (o *ObservableSet) Diff(other *ObservableSet) *ObservableSet {
+	return derive(o.set.diff(&other.set), func(
+		d *ObservableSet, chg SetChange, src int,
+	) {
+		if src == 0 {
+			chg.Added.For(func(elm int) {
+				if !other.set.has(elm) {
+					d.Add(elm)
+				}
+			})
+			chg.Removed.For(func(elm int) { d.Del(elm) })
+			return
+		}
+		chg.Added.For(func(elm int) { d.Del(elm) })
+		chg.Removed.For(func(elm int) {
+			if o.set.has(elm) {
+				d.Add(elm)
+			}
+		})
+	}, o, other)
+}
+
+// Filter returns an observable set which has the elements of receiving
+// set for which given predicate returns true as it changes.  It should
+// not be modified directly.
+func (o *ObservableSet) Filter(pred func(int) bool) *ObservableSet {
+	init := &Set{}
+	o.set.For(func(elm int) {
+		if pred(elm) {
+			init.add(elm)
+		}
+	})
+	return derive(init, func(d *ObservableSet, chg SetChange, _ int) {
+		chg.Added.For(func(elm int) {
+			if pred(elm) {
+				d.Add(elm)
+			}
+		})
+		chg.Removed.For(func(elm int) { d.Del(elm) })
+	}, o)
+}
@@ -0,0 +1,121 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package ints
+
+import (
+	"testing"
+
+	. "github.com/slukits/gounit"
+)
+
+type observableSet struct{ Suite }
+
+func (s *observableSet) SetUp(t *T) { t.Parallel() }
+
+// record subscribes to given set and returns the received changes.
+func record(o *ObservableSet) *[]SetChange {
+	cc := []SetChange{}
+	o.Subscribe(func(c SetChange) { cc = append(cc, c) })
+	return &cc
+}
+
+func (s *observableSet) Notifies_about_added_and_removed_elements(t *T) {
+	fx := &ObservableSet{}
+	cc := record(fx)
+	fx.Add(1, 2, 2, -1)
+	fx.Add(2)
+	fx.Del(1, 7)
+	t.Eq(2, len(*cc))
+	t.Eq("{1, 2}", (*cc)[0].Added.String())
+	t.True((*cc)[0].Removed.IsEmpty())
+	t.Eq("{1}", (*cc)[1].Removed.String())
+	t.Eq("{2}", fx.String())
+}
+
+func (s *observableSet) Stops_notifying_canceled_subscriptions(t *T) {
+	fx, n := &ObservableSet{}, 0
+	cancel := fx.Subscribe(func(SetChange) { n++ })
+	fx.Add(1)
+	cancel()
+	fx.Add(2)
+	t.Eq(1, n)
+}
+
+func (s *observableSet) Coalesces_batch_changes_to_net_change(t *T) {
+	fx := (&ObservableSet{}).Add(5)
+	cc := record(fx)
+	fx.Batch(func() {
+		fx.Add(1, 2)
+		fx.Del(1)
+		fx.Batch(func() { fx.Del(5).Add(5, 3) })
+		fx.Del(2).Del(3).Add(4)
+	})
+	t.Eq(1, len(*cc))
+	t.Eq("{4}", (*cc)[0].Added.String())
+	t.True((*cc)[0].Removed.IsEmpty())
+
+	fx.Batch(func() { fx.Add(6).Del(6) })
+	t.Eq(1, len(*cc))
+}
+
+func (s *observableSet) Union_is_updated_incrementally(t *T) {
+	a, b := (&ObservableSet{}).Add(1, 2), (&ObservableSet{}).Add(2, 3)
+	fx := a.Union(b)
+	cc := record(fx)
+	t.Eq("{1, 2, 3}", fx.String())
+	a.Del(2)
+	t.Eq(0, len(*cc))
+	b.Del(2)
+	t.Eq("{1, 3}", fx.String())
+	b.Add(1, 4)
+	t.Eq("{1, 3, 4}", fx.String())
+	t.Eq("{4}", (*cc)[1].Added.String())
+}
+
+func (s *observableSet) Intersect_is_updated_incrementally(t *T) {
+	a, b := (&ObservableSet{}).Add(1, 2), (&ObservableSet{}).Add(2, 3)
+	fx := a.Intersect(b)
+	t.Eq("{2}", fx.String())
+	a.Add(3)
+	b.Add(1).Del(2)
+	t.Eq("{1, 3}", fx.String())
+}
+
+func (s *observableSet) Diff_is_updated_incrementally(t *T) {
+	a, b := (&ObservableSet{}).Add(1, 2, 3), (&ObservableSet{}).Add(2)
+	fx := a.Diff(b)
+	t.Eq("{1, 3}", fx.String())
+	b.Add(3).Del(2)
+	t.Eq("{1, 2}", fx.String())
+	a.Add(3, 4).Del(1)
+	t.Eq("{2, 4}", fx.String())
+}
+
+func (s *observableSet) Filter_is_updated_incrementally(t *T) {
+	a := (&ObservableSet{}).Add(1, 2, 3, 4)
+	fx := a.Filter(func(e int) bool { return e%2 == 0 })
+	t.Eq("{2, 4}", fx.String())
+	a.Add(5, 6).Del(2)
+	t.Eq("{4, 6}", fx.String())
+}
+
+func (s *observableSet) Derived_sets_notify_once_per_source_batch(t *T) {
+	a, b := &ObservableSet{}, &ObservableSet{}
+	u := a.Union(b)
+	fx := u.Filter(func(e int) bool { return e > 1 })
+	cc := record(fx)
+	a.Batch(func() {
+		a.Add(1, 2, 3)
+		a.Del(3)
+	})
+	t.Eq(1, len(*cc))
+	t.Eq("{2}", (*cc)[0].Added.String())
+	t.Eq("{1, 2}", u.String())
+}
+
+func TestObservableSet(t *testing.T) {
+	t.Parallel()
+	Run(&observableSet{}, t)
+}
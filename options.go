@@ -0,0 +1,273 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package ints
+
+import (
+	"errors"
+	"fmt"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ErrOption is returned by [NewContext] if an option is invalid or
+// contradicts an other option.
+var ErrOption = errors.New("ints: dec: context: invalid option")
+
+// Overflow defines how a context's arithmetic handles results which
+// exceed its Max-property or are negative.
+type Overflow int
+
+const (
+	// OverflowError lets an overflowing operation fail with an
+	// [ErrOverflow]; this is the default.
+	OverflowError Overflow = iota
+	// OverflowSaturate clamps an overflowing sum, product or quotient
+	// to the context's Max-property and a negative difference to zero.
+	OverflowSaturate
+)
+
+// An Option configures a context created by [NewContext].
+type Option func(*options) error
+
+// options collects the settings of a context created by NewContext
+// whereas set keeps track of the already given options.
+type options struct {
+	separator, fmtSeparator, grouping rune
+	fractionals, fmtFractionals       int
+	rounding                          Flags
+	overflow                          Overflow
+	set                               map[string]interface{}
+}
+
+// once records the value v of the option with given name and fails if
+// the option was given before with a different value.
+func (o *options) once(name string, v interface{}) error {
+	if w, ok := o.set[name]; ok && w != v {
+		return fmt.Errorf("%w: contradicting %s %v and %v",
+			ErrOption, name, w, v)
+	}
+	o.set[name] = v
+	return nil
+}
+
+// Separator sets the decimal separator used to convert strings to
+// decimals and to format decimals; see [FmtSeparator] for a different
+// separator of the string representation.  A separator may not be a
+// digit or a sign.
+func Separator(r rune) Option {
+	return func(o *options) error {
+		if err := validSeparator("separator", r); err != nil {
+			return err
+		}
+		o.separator = r
+		return o.once("separator", r)
+	}
+}
+
+// FmtSeparator sets the decimal separator of the string representation
+// of a decimal; it defaults to the separator set by [Separator].
+func FmtSeparator(r rune) Option {
+	return func(o *options) error {
+		if err := validSeparator("format separator", r); err != nil {
+			return err
+		}
+		o.fmtSeparator = r
+		return o.once("format separator", r)
+	}
+}
+
+// Grouping sets the separator which groups the integrals of a decimal's
+// string representation by thousands, e.g. "1'234'567.50" for an
+// apostrophe.  The grouping separator may be a white space but it may
+// neither be a digit nor a sign nor a decimal separator.
+func Grouping(r rune) Option {
+	return func(o *options) error {
+		if err := validSeparator("grouping separator", r); err != nil {
+			return err
+		}
+		o.grouping = r
+		return o.once("grouping separator", r)
+	}
+}
+
+// Fractionals sets a context's number of fractionals which must be in
+// the range of zero to [MaxFractionals].
+func Fractionals(n int) Option {
+	return func(o *options) error {
+		if _, err := FractionalsFlag(n); err != nil {
+			return err
+		}
+		o.fractionals = n
+		return o.once("fractionals", n)
+	}
+}
+
+// FmtFractionals sets the number of fractionals of a decimal's string
+// representation which must be in the range of zero to
+// [MaxFractionals]; it defaults to [UDec]'s format fractionals.
+func FmtFractionals(n int) Option {
+	return func(o *options) error {
+		if _, err := FractionalsFlag(n); err != nil {
+			return err
+		}
+		o.fmtFractionals = n
+		return o.once("format fractionals", n)
+	}
+}
+
+// Rounding sets a context's rounding mode which must be one of the
+// rounding mode flags like [HALF_UP]; see [UContext.New].
+func Rounding(mode Flags) Option {
+	return func(o *options) error {
+		if !ffRoundingsSet[mode] {
+			return ErrRoundingMode
+		}
+		o.rounding = mode
+		return o.once("rounding mode", mode)
+	}
+}
+
+// OnOverflow sets how a context's arithmetic handles overflowing
+// results.
+func OnOverflow(policy Overflow) Option {
+	return func(o *options) error {
+		if policy != OverflowError && policy != OverflowSaturate {
+			return fmt.Errorf("%w: unknown overflow policy %d",
+				ErrOption, policy)
+		}
+		o.overflow = policy
+		return o.once("overflow policy", policy)
+	}
+}
+
+// flagsOption translates the separator and fractionals flag of given
+// flags into the options returned by sep and frc.
+func flagsOption(
+	ff Flags, sep func(rune) Option, frc func(int) Option,
+) Option {
+	return func(o *options) error {
+		if err := validFlags(ff); err != nil {
+			return err
+		}
+		if s := ff & ffSeparators; s != 0 {
+			if err := sep(flagsToSeparator[s])(o); err != nil {
+				return err
+			}
+		}
+		if f := ff & ffFractionals; f != 0 {
+			return frc(flagsToFractionals[f])(o)
+		}
+		return nil
+	}
+}
+
+// validFlags fails if given flags contain more than one separator flag
+// or more than one fractionals flag.
+func validFlags(ff Flags) error {
+	if _, ok := flagsToSeparator[ff&ffSeparators]; !ok {
+		return fmt.Errorf("%w: contradicting separator flags", ErrOption)
+	}
+	if _, ok := flagsToFractionals[ff&ffFractionals]; !ok {
+		return fmt.Errorf("%w: contradicting fractionals flags", ErrOption)
+	}
+	return nil
+}
+
+func validSeparator(name string, r rune) error {
+	switch {
+	case r == 0 || r == utf8.RuneError || !utf8.ValidRune(r):
+		return fmt.Errorf("%w: invalid %s %q", ErrOption, name, r)
+	case unicode.IsDigit(r):
+		return fmt.Errorf("%w: %s %q is a digit", ErrOption, name, r)
+	case r == '-' || r == '+':
+		return fmt.Errorf("%w: %s %q is a sign", ErrOption, name, r)
+	}
+	return nil
+}
+
+// NewContext creates a new context whose settings default to the
+// settings of [UDec] and are overwritten by given options.  NewContext
+// fails with an [ErrOption] if an option is invalid or contradicts an
+// other option, e.g. if the grouping separator equals a decimal
+// separator.  An invalid number of fractionals fails with an
+// [ErrFractionals] and an invalid rounding mode with an
+// [ErrRoundingMode].
+//
+//	chf, err := ints.NewContext(
+//	    ints.Separator('.'),
+//	    ints.Grouping('\''),
+//	    ints.Fractionals(2),
+//	    ints.Rounding(ints.HALF_UP),
+//	)
+//
+// [UContext.New] is the flags based shortcut for the separators, the
+// fractionals and the rounding mode.
+func NewContext(opts ...Option) (*UContext, error) {
+	return UDec.newContext(opts...)
+}
+
+// newContext creates a new context whose settings default to the
+// settings of given context and are overwritten by given options.
+func (c *UContext) newContext(opts ...Option) (*UContext, error) {
+	o := &options{
+		separator:      c.separator,
+		grouping:       c.grouping,
+		fractionals:    c.flags.Fractionals(),
+		fmtFractionals: c.flags.FmtFractionals(),
+		rounding:       c.flags.art & ffRoundings,
+		overflow:       c.overflow,
+		set:            map[string]interface{}{},
+	}
+	for _, opt := range opts {
+		if err := opt(o); err != nil {
+			return nil, err
+		}
+	}
+	fmtSep := o.fmtSeparator
+	if fmtSep == 0 {
+		fmtSep = c.fmtSeparator
+		if _, ok := o.set["separator"]; ok {
+			fmtSep = o.separator
+		}
+	}
+	if o.grouping != 0 && (o.grouping == o.separator ||
+		o.grouping == fmtSep) {
+		return nil, fmt.Errorf(
+			"%w: grouping separator %q equals decimal separator",
+			ErrOption, o.grouping)
+	}
+	art, _ := FractionalsFlag(o.fractionals)
+	fmtFrc, _ := FractionalsFlag(o.fmtFractionals)
+	cntx := &UContext{flags: &flags{art: art | o.rounding, fmt: fmtFrc},
+		separator: o.separator, fmtSeparator: fmtSep,
+		grouping: o.grouping, overflow: o.overflow}
+	initialize(cntx)
+	return cntx, nil
+}
+
+// MNewContext is the 'Must'-variant of [NewContext] which panics if
+// corresponding NewContext-call fails.
+func MNewContext(opts ...Option) *UContext {
+	c, err := NewContext(opts...)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// Overflow returns the overflow policy of given context (see
+// [OnOverflow]).
+func (c *UContext) Overflow() Overflow { return c.overflow }
+
+// saturate returns given bound instead of given error if the error is an
+// [ErrOverflow] and the context's overflow policy is [OverflowSaturate].
+func (c *UContext) saturate(d UDecimal, err error, bound UDecimal) (
+	UDecimal, error,
+) {
+	if err == ErrOverflow && c.overflow == OverflowSaturate {
+		return bound, nil
+	}
+	return d, err
+}
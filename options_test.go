@@ -0,0 +1,190 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package ints
+
+import (
+	"testing"
+
+	. "github.com/slukits/gounit"
+)
+
+type contextOptions struct{ Suite }
+
+func (s *contextOptions) SetUp(t *T) { t.Parallel() }
+
+func (s *contextOptions) Default_to_udec_settings(t *T) {
+	dec := MNewContext()
+	t.Eq(UDec.separator, dec.separator)
+	t.Eq(UDec.fmtSeparator, dec.fmtSeparator)
+	t.Eq(UDec.flags.Fractionals(), dec.flags.Fractionals())
+	t.Eq(UDec.flags.FmtFractionals(), dec.flags.FmtFractionals())
+	t.Eq(UDec.Max, dec.Max)
+	t.Eq(rune(0), dec.grouping)
+	t.Eq("42,50", dec.From.MStr("42.5").Str(dec))
+}
+
+func (s *contextOptions) Accept_any_separator_rune(t *T) {
+	dec := MNewContext(Separator('·'), Fractionals(3))
+	d := dec.From.MStr("12·345")
+	t.Eq(UDecimal(12345), d)
+	t.Eq("12·34", d.Str(dec))
+	dec = MNewContext(Separator(','), FmtSeparator('.'))
+	t.Eq("12.34", dec.From.MStr("12,3451").Str(dec))
+	t.Eq("12.35", dec.From.MStr("12,3451").Rnd(dec))
+}
+
+func (s *contextOptions) Group_integrals_of_string_representation(t *T) {
+	dec := MNewContext(Separator('.'), Grouping('\''), Fractionals(2))
+	t.Eq("1'234'567.50", dec.From.MStr("1234567.5").Str(dec))
+	t.Eq("123.00", dec.From.MStr("123").Str(dec))
+	t.Eq("184'467'440'737'095'516.00",
+		dec.Big.MAdd(dec.Big.From.UDecimal(dec.Max),
+			dec.Big.From.MStr("0.01")).Str(dec))
+	dec = MNewContext(Grouping(' '), FmtFractionals(0))
+	t.Eq("1 234", dec.From.MStr("1234.5").Str(dec))
+}
+
+func (s *contextOptions) Set_fractionals_and_rounding_mode(t *T) {
+	dec := MNewContext(Fractionals(9), FmtFractionals(9),
+		Rounding(HALF_UP))
+	t.Eq(9, dec.flags.Fractionals())
+	t.Eq(HALF_UP, dec.flags.Rounding(DOWN))
+	t.Eq(UDecimal(1_234_567_891), dec.From.MStr("1.2345678905"))
+	t.Eq("1,234567891", UDecimal(1_234_567_891).Str(dec))
+}
+
+func (s *contextOptions) Saturate_overflows_if_set(t *T) {
+	dec := MNewContext(OnOverflow(OverflowSaturate))
+	t.Eq(OverflowSaturate, dec.Overflow())
+	t.Eq(OverflowError, UDec.Overflow())
+	t.Eq(dec.Max, dec.MAdd(dec.Max, 1))
+	t.Eq(UDecimal(0), dec.MSub(1, 2))
+	t.Eq(dec.Max, dec.MMult(dec.Max, dec.From.MStr("2")))
+	t.Eq(dec.Max, dec.MDiv(dec.Max, dec.From.MStr("0.5")))
+	_, err := dec.Div(1, 0)
+	t.ErrIs(err, ErrDividedByZero)
+	_, err = MNewContext().Add(dec.Max, 1)
+	t.ErrIs(err, ErrOverflow)
+}
+
+func (s *contextOptions) Let_big_context_promote_saturating_overflows(t *T) {
+	dec := MNewContext(OnOverflow(OverflowSaturate))
+	max := dec.Big.From.UDecimal(dec.Max)
+	t.True(dec.Big.MAdd(max, dec.Big.From.UDecimal(1)).IsBig())
+	t.True(dec.Big.MMult(max, dec.Big.From.MStr("2")).IsBig())
+	t.True(dec.Big.MDiv(max, dec.Big.From.MStr("0.5")).IsBig())
+	t.Eq("18446744073709,00",
+		dec.Big.MAdd(max, dec.Big.From.MStr("0.000001")).Str(dec))
+}
+
+func (s *contextOptions) Are_kept_by_flags_based_new(t *T) {
+	dec := MNewContext(Separator('·'), Grouping(' '),
+		OnOverflow(OverflowSaturate))
+	cpy := dec.New(FOUR_FRACTIONALS, TWO_FRACTIONALS)
+	t.Eq('·', cpy.separator)
+	t.Eq('·', cpy.fmtSeparator)
+	t.Eq(' ', cpy.grouping)
+	t.Eq(cpy.Max, cpy.MAdd(cpy.Max, 1))
+	cpy.SetFmt(ONE_FRACTIONAL)
+	t.Eq('·', cpy.fmtSeparator)
+	cpy = dec.New(COMMA_SEPARATOR, DOT_SEPARATOR)
+	t.Eq(',', cpy.separator)
+	t.Eq('.', cpy.fmtSeparator)
+}
+
+func (s *contextOptions) Fail_for_invalid_separators(t *T) {
+	for _, opt := range []Option{Separator('7'), Separator('-'),
+		FmtSeparator('+'), Grouping(0), Grouping('٣')} {
+		_, err := NewContext(opt)
+		t.ErrIs(err, ErrOption)
+	}
+	_, err := NewContext(Separator('5'))
+	t.ErrMatched(err, `separator '5' is a digit`)
+}
+
+func (s *contextOptions) Fail_for_contradicting_settings(t *T) {
+	_, err := NewContext(Separator('.'), Grouping('.'))
+	t.ErrIs(err, ErrOption)
+	_, err = NewContext(Grouping(','))
+	t.ErrMatched(err, `grouping separator ',' equals decimal separator`)
+	_, err = NewContext(Fractionals(2), Fractionals(4))
+	t.ErrMatched(err, `contradicting fractionals 2 and 4`)
+	_, err = NewContext(Separator(','), Separator(','))
+	t.FatalOn(err)
+	_, err = NewContext(OnOverflow(Overflow(7)))
+	t.ErrIs(err, ErrOption)
+	t.Panics(func() { MNewContext(Fractionals(-1)) })
+}
+
+func (s *contextOptions) Are_validated_by_checked_new(t *T) {
+	dec := MNewContext(Separator('.'), Grouping(','))
+	for _, ff := range [][2]Flags{
+		{COMMA_SEPARATOR, COMMA_SEPARATOR},
+		{DEFAULTS, COMMA_SEPARATOR},
+		{COMMA_SEPARATOR | DOT_SEPARATOR, DEFAULTS},
+		{DEFAULTS, ONE_FRACTIONAL | TWO_FRACTIONALS},
+	} {
+		_, err := dec.NewChecked(ff[0], ff[1])
+		t.ErrIs(err, ErrOption)
+	}
+	_, err := dec.NewChecked(DEFAULTS, COMMA_SEPARATOR)
+	t.ErrMatched(err, `grouping separator ',' equals decimal separator`)
+	_, err = UDec.NewChecked(UP|DOWN, DEFAULTS)
+	t.ErrIs(err, ErrRoundingMode)
+	_, err = SDec.NewChecked(UP|DOWN, DEFAULTS)
+	t.ErrIs(err, ErrRoundingMode)
+	t.Panics(func() { dec.MNewChecked(COMMA_SEPARATOR, DEFAULTS) })
+	cpy := dec.MNewChecked(TWO_FRACTIONALS, DEFAULTS)
+	t.Eq("123,456,789.00", cpy.From.MStr("123456789").Str(cpy))
+}
+
+func (s *contextOptions) Are_resolved_by_flags_based_new(t *T) {
+	dec := MNewContext(Separator('.'), Grouping(','))
+	cpy := dec.New(COMMA_SEPARATOR, COMMA_SEPARATOR|TWO_FRACTIONALS)
+	t.Eq(rune(0), cpy.grouping)
+	t.Eq("123456789,00", cpy.From.MStr("123456789").Str(cpy))
+	cpy = UDec.New(COMMA_SEPARATOR|DOT_SEPARATOR,
+		ONE_FRACTIONAL|TWO_FRACTIONALS)
+	t.True(cpy.separator == ',' || cpy.separator == '.')
+	t.True(cpy.flags.FmtFractionals() == 1 ||
+		cpy.flags.FmtFractionals() == 2)
+	qty, err := dec.NewFractionals(0, COMMA_SEPARATOR, DEFAULTS)
+	t.FatalOn(err)
+	t.Eq(rune(0), qty.grouping)
+}
+
+func (s *contextOptions) Resolve_format_flags_set_later(t *T) {
+	dec := MNewContext(Separator('.'), Grouping(','), FmtSeparator('.'))
+	dec.SetFmt(COMMA_SEPARATOR)
+	t.Eq(',', dec.fmtSeparator)
+	t.Eq(rune(0), dec.grouping)
+	dec = UDec.New(DEFAULTS, DEFAULTS)
+	dec.SetFmt(COMMA_SEPARATOR | DOT_SEPARATOR)
+	t.Eq(flagsToSeparator[dec.flags.fmt&ffSeparators], dec.fmtSeparator)
+	dec.SetFmt(DOT_SEPARATOR | FOUR_FRACTIONALS)
+	t.Eq("1.5000", dec.From.MStr("1.5").Str(dec))
+	u128 := UDec128.MNew(2, DEFAULTS, DEFAULTS)
+	u128.SetFmt(COMMA_SEPARATOR | DOT_SEPARATOR)
+	t.True(u128.fmtSeparator == ',' || u128.fmtSeparator == '.')
+}
+
+func (s *contextOptions) Fail_for_out_of_range_fractionals(t *T) {
+	_, err := NewContext(Fractionals(MaxFractionals + 1))
+	t.ErrIs(err, ErrFractionals)
+	_, err = NewContext(FmtFractionals(-1))
+	t.ErrIs(err, ErrFractionals)
+}
+
+func (s *contextOptions) Fail_for_invalid_rounding_mode(t *T) {
+	_, err := NewContext(Rounding(TWO_FRACTIONALS))
+	t.ErrIs(err, ErrRoundingMode)
+	_, err = NewContext(Rounding(UP | DOWN))
+	t.ErrIs(err, ErrRoundingMode)
+}
+
+func TestContextOptions(t *testing.T) {
+	t.Parallel()
+	Run(&contextOptions{}, t)
+}
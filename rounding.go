@@ -0,0 +1,235 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package ints
+
+import (
+	"errors"
+	"strings"
+)
+
+// ErrRoundingMode is returned by a rounding operation which is given a
+// mode other than [DEFAULTS] or one of the rounding mode flags like
+// [HALF_UP].
+var ErrRoundingMode = errors.New("ints: dec: round: invalid rounding mode")
+
+// Round rounds given decimal to given number of fractional places
+// according to given mode and returns the rounded decimal of the same
+// context.  Negative places round to tens, hundreds and so on.  Are the
+// places not smaller than the context's fractionals the decimal is
+// returned unchanged.  [DEFAULTS] selects the context's rounding mode
+// or [HALF_EVEN] if it has none.  Round fails with an [ErrOverflow] if
+// the rounded decimal exceeds the context's Max-property and with an
+// [ErrRoundingMode] if given mode is not a rounding mode.
+//
+//	d := ints.UDec.From.MStr("2.345")
+//	r, err := ints.UDec.Round(d, 2, ints.HALF_UP) // 2.35
+func (c *UContext) Round(d UDecimal, places int, mode Flags) (
+	UDecimal, error,
+) {
+	mode, err := c.roundingMode(mode)
+	if err != nil {
+		return 0, err
+	}
+	n := int(c.fractionals) - places
+	if n <= 0 {
+		return d, nil
+	}
+	if n > 19 { // the unit exceeds any decimal
+		if d != 0 && roundUp(mode, -1, false, false) {
+			return 0, ErrOverflow
+		}
+		return 0, nil
+	}
+	return c.roundToMultiple(d, UDecimal(pow10(n)), mode)
+}
+
+// MRound is the 'Must'-variant of [UContext.Round] which panics if
+// corresponding Round-call fails.
+func (c *UContext) MRound(d UDecimal, places int, mode Flags) UDecimal {
+	r, err := c.Round(d, places, mode)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// Truncate cuts given decimal's fractionals after given number of
+// places off.
+func (c *UContext) Truncate(d UDecimal, places int) UDecimal {
+	r, _ := c.Round(d, places, DOWN)
+	return r
+}
+
+// Floor rounds given decimal to given number of places towards
+// negative infinity which is for non-negative decimals the same as
+// [UContext.Truncate].
+func (c *UContext) Floor(d UDecimal, places int) UDecimal {
+	return c.Truncate(d, places)
+}
+
+// Ceil rounds given decimal to given number of places towards positive
+// infinity.  It fails if the result exceeds the context's Max-property.
+func (c *UContext) Ceil(d UDecimal, places int) (UDecimal, error) {
+	return c.Round(d, places, CEILING)
+}
+
+// MCeil is the 'Must'-variant of [UContext.Ceil] which panics if
+// corresponding Ceil-call fails.
+func (c *UContext) MCeil(d UDecimal, places int) UDecimal {
+	return c.MRound(d, places, CEILING)
+}
+
+// RoundToIncrement rounds given decimal to a multiple of given
+// increment of the same context according to given mode which is
+// interpreted as for [UContext.Round], e.g. for cash rounding to
+// 0.05:
+//
+//	inc := ints.UDec.From.MStr("0.05")
+//	r, err := ints.UDec.RoundToIncrement(d, inc, ints.HALF_UP)
+//
+// It fails with an [ErrDividedByZero] if the increment is zero and
+// with an [ErrOverflow] if the result exceeds the context's
+// Max-property.
+func (c *UContext) RoundToIncrement(d, inc UDecimal, mode Flags) (
+	UDecimal, error,
+) {
+	mode, err := c.roundingMode(mode)
+	if err != nil {
+		return 0, err
+	}
+	if inc == 0 {
+		return 0, ErrDividedByZero
+	}
+	return c.roundToMultiple(d, inc, mode)
+}
+
+// MRoundToIncrement is the 'Must'-variant of
+// [UContext.RoundToIncrement] which panics if corresponding
+// RoundToIncrement-call fails.
+func (c *UContext) MRoundToIncrement(d, inc UDecimal, mode Flags) UDecimal {
+	r, err := c.RoundToIncrement(d, inc, mode)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// roundingMode validates given mode and resolves [DEFAULTS].
+func (c *UContext) roundingMode(mode Flags) (Flags, error) {
+	if mode == DEFAULTS {
+		return c.flags.Rounding(HALF_EVEN), nil
+	}
+	if !ffRoundingsSet[mode] {
+		return 0, ErrRoundingMode
+	}
+	return mode, nil
+}
+
+// roundToMultiple returns given decimal rounded to a multiple of given
+// non-zero unit according to given mode.  It fails if the result
+// exceeds the context's Max-property.
+func (c *UContext) roundToMultiple(d, unit UDecimal, mode Flags) (
+	UDecimal, error,
+) {
+	q, r := d/unit, d%unit
+	if r != 0 && roundUp(mode, halfOf(uint64(r), uint64(unit)),
+		q%2 == 1, false) {
+		q++
+	}
+	if q != 0 && unit > c.Max/q {
+		return 0, ErrOverflow
+	}
+	return q * unit, nil
+}
+
+// roundUp returns true if a truncated magnitude must be incremented by
+// one unit according to given rounding mode.  half compares the
+// non-zero truncated remainder with half a unit, odd tells if the
+// truncated magnitude is odd and neg if the rounded value is negative.
+func roundUp(mode Flags, half int, odd, neg bool) bool {
+	switch mode {
+	case DOWN:
+		return false
+	case UP:
+		return true
+	case CEILING:
+		return !neg
+	case FLOOR:
+		return neg
+	}
+	if half != 0 {
+		return half > 0
+	}
+	switch mode {
+	case HALF_UP, HALF_AWAY:
+		return true
+	case HALF_DOWN:
+		return false
+	}
+	return odd // HALF_EVEN
+}
+
+// halfOf compares remainder r with half of divisor d returning -1 if
+// it is smaller, 0 if it is equal and +1 if it is greater.
+func halfOf(r, d uint64) int {
+	switch {
+	case r > d-r:
+		return 1
+	case r == d-r:
+		return 0
+	}
+	return -1
+}
+
+// halfOfDigits compares the truncated fractional digits dd with half a
+// unit of the last kept position.
+func halfOfDigits(dd string) int {
+	switch {
+	case dd[0] > '5':
+		return 1
+	case dd[0] < '5':
+		return -1
+	case strings.Trim(dd[1:], "0") != "":
+		return 1
+	}
+	return 0
+}
+
+// isZeros returns true if given digits are all zero.
+func isZeros(dd string) bool { return strings.Trim(dd, "0") == "" }
+
+// round returns given truncated quotient q incremented by one if the
+// remainder r of divisor d requires it according to the context's
+// rounding mode or given default mode.  It fails if the incremented
+// quotient exceeds the context's Max-property.
+func (c *UContext) round(
+	q, r, d UDecimal, dflt Flags, neg bool,
+) (UDecimal, error) {
+	if r == 0 || !roundUp(c.flags.Rounding(dflt),
+		halfOf(uint64(r), uint64(d)), q%2 == 1, neg) {
+		return q, nil
+	}
+	if q >= c.Max {
+		return 0, ErrOverflow
+	}
+	return q + 1, nil
+}
+
+// roundDigits returns given truncated decimal incremented by one if
+// given truncated fractional digits require it according to the
+// context's rounding mode.  Without a rounding mode digits are
+// truncated.
+func (c *UContext) roundDigits(
+	d UDecimal, dd string, neg bool,
+) (UDecimal, error) {
+	if isZeros(dd) || !roundUp(c.flags.Rounding(DOWN), halfOfDigits(dd),
+		d%2 == 1, neg) {
+		return d, nil
+	}
+	if d >= c.Max {
+		return 0, ErrOverflow
+	}
+	return d + 1, nil
+}
@@ -0,0 +1,235 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package ints
+
+import (
+	"testing"
+
+	. "github.com/slukits/gounit"
+)
+
+type rounding struct{ Suite }
+
+func (s *rounding) SetUp(t *T) { t.Parallel() }
+
+var roundingModes = []struct {
+	name string
+	mode Flags
+	pos  [4]UDecimal // 1.234, 1.235, 1.245, 1.2351
+	neg  [4]SDecimal // negated
+}{
+	{"HALF_EVEN", HALF_EVEN, [4]UDecimal{123, 124, 124, 124},
+		[4]SDecimal{-123, -124, -124, -124}},
+	{"HALF_UP", HALF_UP, [4]UDecimal{123, 124, 125, 124},
+		[4]SDecimal{-123, -124, -125, -124}},
+	{"HALF_DOWN", HALF_DOWN, [4]UDecimal{123, 123, 124, 124},
+		[4]SDecimal{-123, -123, -124, -124}},
+	{"UP", UP, [4]UDecimal{124, 124, 125, 124},
+		[4]SDecimal{-124, -124, -125, -124}},
+	{"DOWN", DOWN, [4]UDecimal{123, 123, 124, 123},
+		[4]SDecimal{-123, -123, -124, -123}},
+	{"CEILING", CEILING, [4]UDecimal{124, 124, 125, 124},
+		[4]SDecimal{-123, -123, -124, -123}},
+	{"FLOOR", FLOOR, [4]UDecimal{123, 123, 124, 123},
+		[4]SDecimal{-124, -124, -125, -124}},
+	{"HALF_AWAY", HALF_AWAY, [4]UDecimal{123, 124, 125, 124},
+		[4]SDecimal{-123, -124, -125, -124}},
+}
+
+var roundingInputs = [4]string{"1.234", "1.235", "1.245", "1.2351"}
+
+func (s *rounding) Applies_mode_when_parsing(t *T) {
+	for _, m := range roundingModes {
+		dec := UDec.New(TWO_FRACTIONALS|m.mode, DEFAULTS)
+		sDec := SDec.New(TWO_FRACTIONALS|m.mode, DEFAULTS)
+		for i, in := range roundingInputs {
+			t.Eq(m.pos[i], dec.From.MStr(in))
+			t.Eq(m.neg[i], sDec.From.MStr("-"+in))
+			t.Eq(m.pos[i], dec.From.MBig(dec.Big.From.MStr(in)))
+		}
+	}
+}
+
+func (s *rounding) Truncates_without_mode(t *T) {
+	dec := UDec.New(TWO_FRACTIONALS, DEFAULTS)
+	for _, in := range roundingInputs {
+		t.True(dec.From.MStr(in) <= 124)
+	}
+	t.Eq(UDecimal(100), dec.From.MStr("1.0099"))
+	t.Eq(UDecimal(1000000), UDec.From.MStr("1.0000009"))
+}
+
+func (s *rounding) Applies_mode_to_products(t *T) {
+	for mode, want := range map[Flags][2]UDecimal{
+		HALF_EVEN: {8, 2}, HALF_UP: {8, 3}, DOWN: {7, 2}, UP: {8, 3},
+	} {
+		dec := UDec.New(TWO_FRACTIONALS|mode, DEFAULTS)
+		t.Eq(want[0], dec.MMult(dec.From.MStr("1.5"),
+			dec.From.MStr("0.05")))
+		t.Eq(want[1], dec.MMult(dec.From.MStr("0.5"),
+			dec.From.MStr("0.05")))
+	}
+	dec := SDec.New(TWO_FRACTIONALS|FLOOR, DEFAULTS)
+	t.Eq(SDecimal(-8), dec.MMult(dec.From.MStr("-1.5"),
+		dec.From.MStr("0.05")))
+	t.Eq(SDecimal(7), dec.MMult(dec.From.MStr("-1.5"),
+		dec.From.MStr("-0.05")))
+}
+
+func (s *rounding) Applies_mode_to_quotients(t *T) {
+	for mode, want := range map[Flags][3]UDecimal{
+		HALF_EVEN: {33, 67, 2}, HALF_UP: {33, 67, 3}, DOWN: {33, 66, 2},
+		UP: {34, 67, 3},
+	} {
+		dec := UDec.New(TWO_FRACTIONALS|mode, DEFAULTS)
+		one, two, three := dec.From.MStr("1"), dec.From.MStr("2"),
+			dec.From.MStr("3")
+		t.Eq(want[0], dec.MDiv(one, three))
+		t.Eq(want[1], dec.MDiv(two, three))
+		t.Eq(want[2], dec.MDiv(dec.From.MStr("0.05"), two))
+	}
+	dec := SDec.New(TWO_FRACTIONALS|CEILING, DEFAULTS)
+	t.Eq(SDecimal(-33), dec.MDiv(dec.From.MStr("-1"), dec.From.MStr("3")))
+	big := UDec.New(HALF_UP, DEFAULTS)
+	t.Eq(UDecimal(666667), big.MDiv(big.Max/4*2, big.Max/4*3))
+}
+
+func (s *rounding) Applies_mode_to_big_decimals(t *T) {
+	dec := UDec.New(TWO_FRACTIONALS|HALF_UP, DEFAULTS)
+	max := dec.Big.From.UDecimal(dec.Max)
+	prd := dec.Big.MMult(max, dec.Big.From.MStr("1.5"))
+	t.True(prd.IsBig())
+	t.Eq("276701161105643273,99", prd.Str(dec)) // ...273.985
+	t.Eq("39528737300806182,00", dec.Big.MDiv(prd, dec.Big.From.MStr(
+		"7")).Str(dec)) // ...181.99857
+	t.Eq(UDecimal(4), dec.From.MBig(dec.Big.MDiv(dec.Big.From.MStr(
+		"0.07"), dec.Big.From.MStr("2"))))
+	dec = UDec.New(TWO_FRACTIONALS, DEFAULTS)
+	t.Eq("276701161105643273,98", dec.Big.MMult(max, dec.Big.From.MStr(
+		"1.5")).Str(dec))
+}
+
+func (s *rounding) Applies_mode_to_floats_and_ints(t *T) {
+	dec := UDec.New(TWO_FRACTIONALS|HALF_UP, DEFAULTS)
+	t.Eq(UDecimal(101), dec.From.MFloat(1.005))
+	t.Eq(UDecimal(124), dec.From.MInts(1, 235, 0))
+	t.Eq(UDecimal(105), dec.From.MInts(1, 45, 1))
+	sDec := SDec.New(TWO_FRACTIONALS|HALF_UP, DEFAULTS)
+	t.Eq(SDecimal(-101), sDec.From.MFloat(-1.005))
+	t.Eq(SDecimal(-124), sDec.From.MInts(true, 1, 235, 0))
+	t.Eq(UDecimal(101), dec.From.MBig(dec.Big.From.MFloat(1.005)))
+}
+
+func (s *rounding) Applies_receiving_mode_converting_contexts(t *T) {
+	from := UDec.New(THREE_FRACTIONALS, DEFAULTS)
+	d := from.From.MStr("1.245")
+	t.Eq(UDecimal(124), UDec.New(TWO_FRACTIONALS, DEFAULTS).From.MCntx(
+		d, from))
+	t.Eq(UDecimal(125), UDec.New(TWO_FRACTIONALS|HALF_UP,
+		DEFAULTS).From.MCntx(d, from))
+	sFrom := SDec.New(THREE_FRACTIONALS, DEFAULTS)
+	t.Eq(SDecimal(-125), SDec.New(TWO_FRACTIONALS|HALF_UP,
+		DEFAULTS).From.MCntx(sFrom.From.MStr("-1.245"), sFrom))
+	t.Eq(SDecimal(-125), SDec.New(TWO_FRACTIONALS|FLOOR,
+		DEFAULTS).From.MCntx(sFrom.From.MStr("-1.241"), sFrom))
+}
+
+func (s *rounding) Keeps_mode_of_copied_context(t *T) {
+	dec := UDec.New(HALF_UP, DEFAULTS).New(TWO_FRACTIONALS, DEFAULTS)
+	t.Eq(UDecimal(125), dec.From.MStr("1.245"))
+	dec = dec.New(DOWN, DEFAULTS)
+	t.Eq(UDecimal(124), dec.From.MStr("1.245"))
+}
+
+func (s *rounding) Rounds_values_to_given_places(t *T) {
+	dec := UDec.New(FOUR_FRACTIONALS, DEFAULTS)
+	for _, m := range roundingModes {
+		for i, in := range roundingInputs {
+			t.Eq(m.pos[i]*100, dec.MRound(dec.From.MStr(in), 2, m.mode))
+		}
+	}
+}
+
+func (s *rounding) Rounds_values_to_context_mode_by_default(t *T) {
+	dec := UDec.New(FOUR_FRACTIONALS, DEFAULTS)
+	t.Eq(dec.From.MStr("1.24"), dec.MRound(dec.From.MStr("1.235"), 2,
+		DEFAULTS))
+	dec = UDec.New(FOUR_FRACTIONALS|HALF_DOWN, DEFAULTS)
+	t.Eq(dec.From.MStr("1.23"), dec.MRound(dec.From.MStr("1.235"), 2,
+		DEFAULTS))
+}
+
+func (s *rounding) Rounds_values_to_negative_places(t *T) {
+	dec := UDec.New(TWO_FRACTIONALS, DEFAULTS)
+	d := dec.From.MStr("1250.5")
+	t.Eq(dec.From.MStr("1300"), dec.MRound(d, -2, HALF_UP))
+	t.Eq(dec.From.MStr("1000"), dec.Truncate(d, -3))
+	t.Eq(UDecimal(0), dec.Truncate(d, -30))
+	_, err := dec.Round(d, -30, UP)
+	t.ErrIs(err, ErrOverflow)
+}
+
+func (s *rounding) Keeps_value_not_exceeding_given_places(t *T) {
+	dec := UDec.New(TWO_FRACTIONALS, DEFAULTS)
+	d := dec.From.MStr("1.25")
+	t.Eq(d, dec.MRound(d, 2, UP))
+	t.Eq(d, dec.MRound(d, 5, UP))
+}
+
+func (s *rounding) Truncates_floors_and_ceils_values(t *T) {
+	dec := UDec.New(FOUR_FRACTIONALS, DEFAULTS)
+	d := dec.From.MStr("1.2301")
+	t.Eq(dec.From.MStr("1.23"), dec.Truncate(d, 2))
+	t.Eq(dec.From.MStr("1.23"), dec.Floor(d, 2))
+	t.Eq(dec.From.MStr("1.24"), dec.MCeil(d, 2))
+	t.Eq(dec.From.MStr("2"), dec.MCeil(d, 0))
+}
+
+func (s *rounding) Fails_rounding_beyond_max(t *T) {
+	_, err := UDec.Ceil(UDec.Max, 0)
+	t.ErrIs(err, ErrOverflow)
+	_, err = UDec.RoundToIncrement(UDec.Max, UDec.From.MStr("0.05"), UP)
+	t.ErrIs(err, ErrOverflow)
+	t.Panics(func() { UDec.MCeil(UDec.Max, 0) })
+}
+
+func (s *rounding) Fails_rounding_with_invalid_mode(t *T) {
+	_, err := UDec.Round(1, 0, TWO_FRACTIONALS)
+	t.ErrIs(err, ErrRoundingMode)
+	_, err = UDec.Round(1, 0, UP|DOWN)
+	t.ErrIs(err, ErrRoundingMode)
+	_, err = UDec.RoundToIncrement(1, 1, COMMA_SEPARATOR)
+	t.ErrIs(err, ErrRoundingMode)
+}
+
+func (s *rounding) Rounds_to_cash_increments(t *T) {
+	dec := UDec.New(FOUR_FRACTIONALS, DEFAULTS)
+	chf := dec.From.MStr("0.05")
+	for in, exp := range map[string]string{
+		"1.02": "1.00", "1.03": "1.05", "1.025": "1.00", "1.075": "1.10",
+		"1.07": "1.05", "1.10": "1.10",
+	} {
+		t.Eq(dec.From.MStr(exp), dec.MRoundToIncrement(
+			dec.From.MStr(in), chf, HALF_EVEN))
+	}
+	tick := dec.From.MStr("0.25")
+	t.Eq(dec.From.MStr("10.25"), dec.MRoundToIncrement(
+		dec.From.MStr("10.13"), tick, HALF_UP))
+	t.Eq(dec.From.MStr("10.25"), dec.MRoundToIncrement(
+		dec.From.MStr("10.01"), tick, CEILING))
+	t.Eq(dec.From.MStr("10"), dec.MRoundToIncrement(
+		dec.From.MStr("10.24"), tick, FLOOR))
+}
+
+func (s *rounding) Fails_rounding_to_zero_increment(t *T) {
+	_, err := UDec.RoundToIncrement(1, 0, HALF_UP)
+	t.ErrIs(err, ErrDividedByZero)
+	t.Panics(func() { UDec.MRoundToIncrement(1, 0, HALF_UP) })
+}
+
+func TestRounding(t *testing.T) {
+	t.Parallel()
+	Run(&rounding{}, t)
+}
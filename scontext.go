@@ -0,0 +1,160 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package ints
+
+import "math"
+
+// A SContext represents an environment to do arithmetic with int64-based
+// signed decimals.  It mirrors [UContext] and has the same arithmetic
+// and format flags.  A SContext's zero value is NOT ready to use.
+// Create a new context with needed flags by calling [ints.SDec]'s
+// [SContext.New] method.
+type SContext struct {
+	u *UContext
+
+	// From provides conversion methods returning SDecimal-values.
+	From *SConvert
+
+	// Max is the maximal representable SDecimal value having a
+	// context's set arithmetic fractionals while -Max is the minimal
+	// representable value.
+	Max SDecimal
+}
+
+// SDec returns the default signed context whose flags default to the
+// flags of [UDec], i.e. to [DOT_SEPARATOR] | [SIX_FRACTIONALS] for
+// the arithmetic flags and [COMMA_SEPARATOR] | [TWO_FRACTIONALS] for the
+// format flags.
+var SDec = newSContext(UDec.New(DEFAULTS, DEFAULTS))
+
+// New creates a new SContext-instance with provided arithmetic and
+// format flags.  The flags are handled like the flags of
+// [UContext.New].
+func (c *SContext) New(art, fmt Flags) *SContext {
+	if c == nil || c.u == nil {
+		return SDec.New(art, fmt)
+	}
+	return newSContext(c.u.New(art, fmt))
+}
+
+// NewChecked creates a new SContext-instance like [SContext.New] does
+// but validates given flags like [UContext.NewChecked].
+func (c *SContext) NewChecked(art, fmt Flags) (*SContext, error) {
+	if c == nil || c.u == nil {
+		return SDec.NewChecked(art, fmt)
+	}
+	u, err := c.u.NewChecked(art, fmt)
+	if err != nil {
+		return nil, err
+	}
+	return newSContext(u), nil
+}
+
+func newSContext(u *UContext) *SContext {
+	max := uint64(math.MaxInt64)
+	max -= max % uint64(u.pow)
+	c := &SContext{u: u, Max: SDecimal(max - 1)}
+	c.From = &SConvert{cntx: c}
+	return c
+}
+
+// SetFmt sets given context's format flags.  Is more than one
+// fractional or separator flag given only one of them is used and it
+// is undefined which one.
+func (c *SContext) SetFmt(ff Flags) { c.u.SetFmt(ff) }
+
+// Add adds given decimals and returns their sum.  Add fails if the
+// absolute value of the sum exceeds given context's Max property.
+func (c *SContext) Add(a, b SDecimal) (SDecimal, error) {
+	if b > 0 && a > c.Max-b || b < 0 && a < -c.Max-b {
+		return 0, ErrOverflow
+	}
+	return a + b, nil
+}
+
+// MAdd is the 'Must'-version of [SContext.Add] which panics if
+// corresponding Add-call fails.
+func (c *SContext) MAdd(a, b SDecimal) SDecimal {
+	sum, err := c.Add(a, b)
+	if err != nil {
+		panic(err)
+	}
+	return sum
+}
+
+// Sub subtracts given decimal b from a and returns their difference.
+// Sub fails if the absolute value of the difference exceeds given
+// context's Max property.
+func (c *SContext) Sub(a, b SDecimal) (SDecimal, error) {
+	return c.Add(a, -b)
+}
+
+// MSub is the 'Must'-version of [SContext.Sub] which panics if
+// corresponding Sub-call fails.
+func (c *SContext) MSub(a, b SDecimal) SDecimal {
+	diff, err := c.Sub(a, b)
+	if err != nil {
+		panic(err)
+	}
+	return diff
+}
+
+// Mult multiplies given decimals and returns their product.  Mult fails
+// if the absolute value of the product exceeds given context's Max
+// property.  Superfluous fractionals are rounded according to the
+// context's rounding mode; without a rounding mode they are truncated,
+// i.e. the product is rounded towards zero.
+func (c *SContext) Mult(a, b SDecimal) (SDecimal, error) {
+	prd, err := c.u.mult(a.abs(), b.abs(), a.Sign()*b.Sign() < 0)
+	if err != nil {
+		return 0, err
+	}
+	return c.signed(prd, a.Sign()*b.Sign())
+}
+
+// MMult is the 'Must'-variant of [SContext.Mult] which panics if
+// corresponding Mult-call fails.
+func (c *SContext) MMult(a, b SDecimal) SDecimal {
+	prd, err := c.Mult(a, b)
+	if err != nil {
+		panic(err)
+	}
+	return prd
+}
+
+// Div divides a by b and returns resulting quotient.  Div fails if the
+// absolute value of the quotient exceeds given context's Max property
+// or if b is zero.  The quotient is rounded according to the context's
+// rounding mode; without a rounding mode it is truncated, i.e. rounded
+// towards zero.
+func (c *SContext) Div(a, b SDecimal) (SDecimal, error) {
+	qut, err := c.u.div(a.abs(), b.abs(), a.Sign()*b.Sign() < 0)
+	if err != nil {
+		return 0, err
+	}
+	return c.signed(qut, a.Sign()*b.Sign())
+}
+
+// MDiv is the 'Must'-variant of [SContext.Div] which panics if
+// corresponding Div-call fails.
+func (c *SContext) MDiv(a, b SDecimal) SDecimal {
+	qut, err := c.Div(a, b)
+	if err != nil {
+		panic(err)
+	}
+	return qut
+}
+
+// signed returns given magnitude with given sign; it fails if the
+// magnitude exceeds the context's Max property.
+func (c *SContext) signed(d UDecimal, sign int) (SDecimal, error) {
+	if d > UDecimal(c.Max) {
+		return 0, ErrOverflow
+	}
+	if sign < 0 {
+		return -SDecimal(d), nil
+	}
+	return SDecimal(d), nil
+}
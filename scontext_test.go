@@ -0,0 +1,68 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package ints
+
+import (
+	"testing"
+
+	. "github.com/slukits/gounit"
+)
+
+type sContext struct{ Suite }
+
+func (s *sContext) SetUp(t *T) { t.Parallel() }
+
+func (s *sContext) Defaults_to_udec_flags(t *T) {
+	t.Eq(6, SDec.u.flags.Fractionals())
+	t.Eq(SDecimal(9223372036853999999), SDec.Max)
+	t.Eq("-1,50", SDec.From.MStr("-1.5").Str(SDec))
+	got := (*SContext)(nil).New(FOUR_FRACTIONALS, DOT_SEPARATOR)
+	t.Eq(4, got.u.flags.Fractionals())
+	t.Eq("-1.50", got.From.MStr("-1.5").Str(got))
+}
+
+func (s *sContext) Adds_and_subtracts_across_zero(t *T) {
+	a, b := SDec.From.MStr("1.25"), SDec.From.MStr("3.5")
+	t.Eq(SDec.From.MStr("-2.25"), SDec.MSub(a, b))
+	t.Eq(SDec.From.MStr("2.25"), SDec.MSub(b, a))
+	t.Eq(SDec.From.MStr("-4.75"), SDec.MAdd(a.Neg(), b.Neg()))
+	t.Eq(SDec.From.MStr("2.25"), SDec.MAdd(a.Neg(), b))
+}
+
+func (s *sContext) Overflows_if_sum_exceeds_max(t *T) {
+	_, err := SDec.Add(SDec.Max, 1)
+	t.ErrIs(err, ErrOverflow)
+	_, err = SDec.Sub(-SDec.Max, 1)
+	t.ErrIs(err, ErrOverflow)
+	t.Panics(func() { SDec.MAdd(-SDec.Max, -1) })
+	t.Panics(func() { SDec.MSub(SDec.Max, -1) })
+	t.Eq(SDecimal(0), SDec.MAdd(SDec.Max, -SDec.Max))
+}
+
+func (s *sContext) Multiplies_with_sign_truncating_towards_zero(t *T) {
+	a, b := SDec.From.MFloat(1.2345), SDec.From.MFloat(-1.2345)
+	t.Eq(SDecimal(-1523990), SDec.MMult(a, b))
+	t.Eq(SDecimal(1523990), SDec.MMult(b, b))
+	t.Eq(SDecimal(0), SDec.MMult(b, 0))
+	_, err := SDec.Mult(SDec.Max, SDec.From.MInts(true, 2, 0, 0))
+	t.ErrIs(err, ErrOverflow)
+	t.Panics(func() { SDec.MMult(-SDec.Max, SDec.Max) })
+}
+
+func (s *sContext) Divides_with_sign_truncating_towards_zero(t *T) {
+	a, b := SDec.From.MFloat(-1.2345), SDec.From.MFloat(2.3457)
+	t.Eq(SDecimal(-526282), SDec.MDiv(a, b))
+	t.Eq(SDecimal(526282), SDec.MDiv(a, b.Neg()))
+	_, err := SDec.Div(a, 0)
+	t.ErrIs(err, ErrDividedByZero)
+	_, err = SDec.Div(-SDec.Max, SDec.From.MStr("0.5"))
+	t.ErrIs(err, ErrOverflow)
+	t.Panics(func() { SDec.MDiv(a, 0) })
+}
+
+func TestSContext(t *testing.T) {
+	t.Parallel()
+	Run(&sContext{}, t)
+}
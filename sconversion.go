@@ -0,0 +1,148 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package ints
+
+import (
+	"math"
+	"strconv"
+)
+
+// SConvert provides the [SDecimal]-value creating conversion functions
+// of a [SContext]'s From-property.  Its methods parse or convert the
+// absolute value as their [UConvert] counterparts do and apply the
+// sign.
+type SConvert struct {
+	cntx *SContext
+}
+
+// Str converts given string with an optional leading '-' or '+' sign
+// to a [SDecimal]-value.  See [UConvert.Str] for the conversion of the
+// unsigned rest.  Str fails with a syntax error if given string is a
+// lone sign and it fails if the absolute value of the resulting
+// decimal is greater than the associated [SContext]'s Max-property.
+func (c SConvert) Str(s string) (SDecimal, error) {
+	sign := 1
+	if len(s) > 0 && (s[0] == '-' || s[0] == '+') {
+		if len(s) == 1 {
+			return 0, &strconv.NumError{Func: "ParseInt", Num: s,
+				Err: strconv.ErrSyntax}
+		}
+		if s[0] == '-' {
+			sign = -1
+		}
+		s = s[1:]
+	}
+	d, err := c.cntx.u.From.str(s, sign < 0)
+	if err != nil {
+		return 0, err
+	}
+	return c.cntx.signed(d, sign)
+}
+
+// MStr is the "must"-variant of [SConvert.Str] which panics if
+// corresponding Str-call fails.
+func (c SConvert) MStr(s string) SDecimal {
+	v, err := c.Str(s)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float converts given float to a [SDecimal]-value.  It overflows if
+// the absolute value of the resulting decimal is greater than the
+// associated [SContext]'s Max-property; see [UConvert.Float] for the
+// handling of superfluous fractionals.
+func (c SConvert) Float(f float64) (SDecimal, error) {
+	d, err := c.cntx.u.From.float(math.Abs(f), f < 0)
+	if err != nil {
+		return 0, err
+	}
+	if f < 0 {
+		return c.cntx.signed(d, -1)
+	}
+	return c.cntx.signed(d, 1)
+}
+
+// MFloat is the "Must"-variant of [SConvert.Float] which panics if
+// corresponding Float-call fails.
+func (c SConvert) MFloat(f float64) SDecimal {
+	v, err := c.Float(f)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints converts given integrals, fractionals and leading zeros lz of
+// the fractionals to a [SDecimal]-value which is negative if neg is
+// true.  See [UConvert.Ints].
+func (c SConvert) Ints(
+	neg bool, integrals, fractionals uint64, lz int,
+) (SDecimal, error) {
+	d, err := c.cntx.u.From.ints(integrals, fractionals, lz, neg)
+	if err != nil {
+		return 0, err
+	}
+	if neg {
+		return c.cntx.signed(d, -1)
+	}
+	return c.cntx.signed(d, 1)
+}
+
+// MInts is the "Must"-variant of [SConvert.Ints] which panics if
+// corresponding Ints-call fails.
+func (c SConvert) MInts(
+	neg bool, integrals, fractionals uint64, lz int,
+) SDecimal {
+	v, err := c.Ints(neg, integrals, fractionals, lz)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Cntx converts given decimal of given context to a decimal of
+// receiving context.  Cntx fails if the conversion overflows.  If
+// receiving context has less fractionals than given context the
+// value is rounded as described at [UConvert.Cntx].
+func (c SConvert) Cntx(d SDecimal, cx *SContext) (SDecimal, error) {
+	v, err := c.cntx.u.From.cntxConvert(d.abs(), cx.u, d < 0)
+	if err != nil {
+		return 0, err
+	}
+	return c.cntx.signed(v, d.Sign())
+}
+
+// MCntx is the "Must"-variant of [SConvert.Cntx] which panics if
+// corresponding Cntx-call fails.
+func (c SConvert) MCntx(d SDecimal, cx *SContext) SDecimal {
+	d, err := c.Cntx(d, cx)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+// UDecimal converts given non-negative decimal of given unsigned
+// context to a decimal of receiving context.  It fails if the
+// conversion overflows.
+func (c SConvert) UDecimal(d UDecimal, cx *UContext) (SDecimal, error) {
+	v, err := c.cntx.u.From.Cntx(d, cx)
+	if err != nil {
+		return 0, err
+	}
+	return c.cntx.signed(v, 1)
+}
+
+// MUDecimal is the "Must"-variant of [SConvert.UDecimal] which panics
+// if corresponding UDecimal-call fails.
+func (c SConvert) MUDecimal(d UDecimal, cx *UContext) SDecimal {
+	v, err := c.UDecimal(d, cx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
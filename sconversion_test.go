@@ -0,0 +1,70 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package ints
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	. "github.com/slukits/gounit"
+)
+
+type sConversion struct{ Suite }
+
+func (s *sConversion) SetUp(t *T) { t.Parallel() }
+
+func (s *sConversion) Parses_leading_sign(t *T) {
+	t.Eq(SDecimal(-4200000), SDec.From.MStr("-4.2"))
+	t.Eq(SDecimal(4200000), SDec.From.MStr("+4.2"))
+	t.Eq(SDecimal(4200000), SDec.From.MStr("4.2"))
+	t.Eq(SDecimal(0), SDec.From.MStr("-0.0"))
+	_, err := SDec.From.Str("--4.2")
+	t.ErrMatched(err, "invalid syntax")
+	_, err = SDec.From.Str("4.-2")
+	t.ErrMatched(err, "invalid syntax")
+	for _, sign := range []string{"-", "+"} {
+		_, err = SDec.From.Str(sign)
+		t.ErrIs(err, strconv.ErrSyntax)
+		t.Panics(func() { SDec.From.MStr(sign) })
+	}
+}
+
+func (s *sConversion) Overflows_if_absolute_value_exceeds_max(t *T) {
+	max := SDec.Max.Integrals(SDec)
+	_, err := SDec.From.Str(fmt.Sprintf("-%d", max+1))
+	t.ErrIs(err, ErrOverflow)
+	t.Panics(func() { SDec.From.MStr(fmt.Sprintf("%d", max+1)) })
+	t.Eq(-max*1000000, SDec.From.MStr(fmt.Sprintf("-%d", max)))
+	_, err = SDec.From.Ints(true, uint64(max)+1, 0, 0)
+	t.ErrIs(err, ErrOverflow)
+	_, err = SDec.From.Float(-1e19)
+	t.ErrIs(err, ErrOverflow)
+}
+
+func (s *sConversion) Converts_floats_and_ints_truncating(t *T) {
+	dec := SDec.New(FOUR_FRACTIONALS, DEFAULTS)
+	t.Eq(SDecimal(-12345), dec.From.MFloat(-1.23456))
+	t.Eq(SDecimal(12345), dec.From.MFloat(1.23456))
+	t.Eq(SDecimal(-12345), dec.From.MStr("-1.23456"))
+	t.Eq(SDecimal(-10002), dec.From.MInts(true, 1, 2, 3))
+	t.Eq(SDecimal(-2), dec.From.MInts(true, 0, 2, 3))
+}
+
+func (s *sConversion) Converts_to_a_different_context(t *T) {
+	dec := SDec.New(TWO_FRACTIONALS, DEFAULTS)
+	t.Eq(SDecimal(-124), dec.From.MCntx(SDec.From.MStr("-1.235"), SDec))
+	t.Eq(SDecimal(-1240000), SDec.From.MCntx(-124, dec))
+	t.Eq(SDecimal(1240000), SDec.From.MUDecimal(124, UDec.New(
+		TWO_FRACTIONALS, DEFAULTS)))
+	_, err := SDec.From.UDecimal(UDec.Max, UDec)
+	t.ErrIs(err, ErrOverflow)
+	t.Panics(func() { SDec.From.MUDecimal(UDec.Max, UDec) })
+}
+
+func TestSConversion(t *testing.T) {
+	t.Parallel()
+	Run(&sConversion{}, t)
+}
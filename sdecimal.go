@@ -0,0 +1,89 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package ints
+
+import "strings"
+
+// SDecimal value represents together with a [SContext]-instance a
+// signed decimal value whose last n positions are interpreted as the
+// decimal's fractionals.  See [UDecimal] for the relation between a
+// value and its context.  A valid SDecimal's absolute value doesn't
+// exceed its context's Max property, hence [SDecimal.Neg] and
+// [SDecimal.Abs] never overflow.
+type SDecimal int64
+
+// Sign returns -1 if given decimal is negative, 0 if it is zero and +1
+// if it is positive.
+func (d SDecimal) Sign() int {
+	switch {
+	case d < 0:
+		return -1
+	case d > 0:
+		return 1
+	}
+	return 0
+}
+
+// Neg returns given decimal with inverted sign.
+func (d SDecimal) Neg() SDecimal { return -d }
+
+// Abs returns given decimal's absolute value.
+func (d SDecimal) Abs() SDecimal {
+	if d < 0 {
+		return -d
+	}
+	return d
+}
+
+// Cmp compares given decimals d and e and returns -1 if d < e, 0 if
+// d == e and +1 if d > e.
+func (d SDecimal) Cmp(e SDecimal) int {
+	switch {
+	case d < e:
+		return -1
+	case d > e:
+		return 1
+	}
+	return 0
+}
+
+func (d SDecimal) abs() UDecimal { return UDecimal(d.Abs()) }
+
+// Integrals returns a decimal's signed integral part relative to
+// provided context's ..._FRACTIONALS arithmetic-flag.
+func (d SDecimal) Integrals(c *SContext) SDecimal {
+	return d / SDecimal(c.u.pow)
+}
+
+// Fractionals returns a decimal's signed fractional part relative to
+// provided context's ..._FRACTIONALS arithmetic-flag.
+func (d SDecimal) Fractionals(c *SContext) SDecimal {
+	return d % SDecimal(c.u.pow)
+}
+
+// Str returns a string representation of given value relative to the
+// settings of given context having a leading minus sign if the value
+// is negative.  Superfluous fractionals are truncated as described at
+// [UDecimal.Str].  A negative value which is truncated to zero is
+// represented without sign.
+func (d SDecimal) Str(c *SContext) string {
+	return d.signed(d.abs().Str(c.u))
+}
+
+// Rnd returns a rounded to even string representation of given value
+// having a leading minus sign if the value is negative.  Rounding is
+// symmetric to zero, i.e. the absolute value is rounded as described
+// at [UDecimal.Rnd].  A negative value which is rounded to zero is
+// represented without sign.
+func (d SDecimal) Rnd(c *SContext) string {
+	return d.signed(d.abs().Rnd(c.u))
+}
+
+func (d SDecimal) signed(s string) string {
+	if d >= 0 || !strings.ContainsAny(s, "123456789") {
+		return s
+	}
+	return "-" + s
+}
@@ -0,0 +1,59 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package ints
+
+import (
+	"testing"
+
+	. "github.com/slukits/gounit"
+)
+
+type sDecimal struct{ Suite }
+
+func (s *sDecimal) SetUp(t *T) { t.Parallel() }
+
+func (s *sDecimal) Has_sign_absolute_value_and_negation(t *T) {
+	d := SDec.From.MStr("-42.5")
+	t.Eq(-1, d.Sign())
+	t.Eq(1, d.Neg().Sign())
+	t.Eq(0, SDecimal(0).Sign())
+	t.Eq(d.Neg(), d.Abs())
+	t.Eq(d.Neg(), d.Neg().Abs())
+	t.Eq(SDec.Max, (-SDec.Max).Abs())
+}
+
+func (s *sDecimal) Compares_decimals(t *T) {
+	t.Eq(-1, (-SDec.Max).Cmp(SDec.Max))
+	t.Eq(1, SDec.Max.Cmp(-SDec.Max))
+	t.Eq(0, SDecimal(-5).Cmp(-5))
+}
+
+func (s *sDecimal) Provides_signed_integral_and_fractional_parts(t *T) {
+	d := SDec.From.MStr("-42.42")
+	t.Eq(SDecimal(-42), d.Integrals(SDec))
+	t.Eq(SDecimal(-420000), d.Fractionals(SDec))
+}
+
+func (s *sDecimal) String_has_leading_minus_if_negative(t *T) {
+	dec := SDec.New(DEFAULTS, DOT_SEPARATOR|TWO_FRACTIONALS)
+	t.Eq("-3.02", dec.From.MStr("-3.029").Str(dec))
+	t.Eq("3.02", dec.From.MStr("3.029").Str(dec))
+	t.Eq("0.00", dec.From.MStr("-0.009").Str(dec))
+	t.Eq("0.00", SDecimal(0).Str(dec))
+}
+
+func (s *sDecimal) Rounds_symmetrically_to_zero(t *T) {
+	dec := SDec.New(DOT_SEPARATOR, DOT_SEPARATOR|TWO_FRACTIONALS)
+	t.Eq("-3.03", dec.From.MStr("-3.029").Rnd(dec))
+	t.Eq("-3.02", dec.From.MStr("-3.025").Rnd(dec))
+	t.Eq("-3.04", dec.From.MStr("-3.035").Rnd(dec))
+	t.Eq("3.04", dec.From.MStr("3.035").Rnd(dec))
+	t.Eq("0.00", dec.From.MStr("-0.004").Rnd(dec))
+}
+
+func TestSDecimal(t *testing.T) {
+	t.Parallel()
+	Run(&sDecimal{}, t)
+}
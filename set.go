@@ -5,6 +5,7 @@
 package ints
 
 import (
+	"math/bits"
 	"strconv"
 	"strings"
 )
@@ -38,6 +39,14 @@ func (s *Set) ToSlice() (elms []int) {
 	return
 }
 
+// copy returns a copy of receiving set.
+func (s *Set) copy() *Set {
+	return &Set{
+		words:       append([]uint(nil), s.words...),
+		cardinality: s.cardinality,
+	}
+}
+
 // Eq returns true if receiving set has the same elements as given other
 // set.
 func (s *Set) Eq(other *Set) bool {
@@ -104,6 +113,30 @@ func (s *Set) add(elm int) {
 	s.words[word] |= 1 << bit
 }
 
+// addRange adds the elements from lo to hi (excluded) word by word.
+func (s *Set) addRange(lo, hi int) {
+	if lo < 0 {
+		lo = 0
+	}
+	if lo >= hi {
+		return
+	}
+	if n := (hi-1)/wordLength + 1; n > len(s.words) {
+		s.words = append(s.words, make([]uint, n-len(s.words))...)
+	}
+	for lo < hi {
+		word, bit := lo/wordLength, uint(lo%wordLength)
+		n := wordLength - int(bit)
+		if hi-lo < n {
+			n = hi - lo
+		}
+		mask := ^uint(0) >> uint(wordLength-n) << bit
+		s.cardinality += bits.OnesCount(mask &^ s.words[word])
+		s.words[word] |= mask
+		lo += n
+	}
+}
+
 // Add adds given integers to receiving set.
 func (s *Set) Add(elms ...int) *Set {
 	for _, elm := range elms {
@@ -126,6 +159,25 @@ func (s *Set) For(elm func(int)) {
 	}
 }
 
+// forRuns calls back for each maximal run [lo, hi) of consecutive
+// elements in ascending order.
+func (s *Set) forRuns(run func(lo, hi int)) {
+	lo, hi := -1, -1
+	s.For(func(elm int) {
+		if elm == hi {
+			hi++
+			return
+		}
+		if lo >= 0 {
+			run(lo, hi)
+		}
+		lo, hi = elm, elm+1
+	})
+	if lo >= 0 {
+		run(lo, hi)
+	}
+}
+
 func (s *Set) del(elm int) {
 	if !s.has(elm) {
 		return
@@ -149,6 +201,47 @@ func (s *Set) Del(elm int, elms ...int) *Set {
 	return s
 }
 
+// union returns a new set with the elements of receiving and given
+// other set.
+func (s *Set) union(other *Set) *Set {
+	long, short := s, other
+	if len(short.words) > len(long.words) {
+		long, short = short, long
+	}
+	u := long.copy()
+	for i, w := range short.words {
+		u.words[i] |= w
+	}
+	u.count()
+	return u
+}
+
+// intersect returns a new set with the elements which are in receiving
+// and in given other set.
+func (s *Set) intersect(other *Set) *Set {
+	n := len(s.words)
+	if len(other.words) < n {
+		n = len(other.words)
+	}
+	x := &Set{words: make([]uint, n)}
+	for i := range x.words {
+		x.words[i] = s.words[i] & other.words[i]
+	}
+	x.count()
+	return x
+}
+
+// diff returns a new set with the elements of receiving set which are
+// not in given other set.
+func (s *Set) diff(other *Set) *Set {
+	d := s.copy()
+	for i := 0; i < len(d.words) && i < len(other.words); i++ {
+		d.words[i] &^= other.words[i]
+	}
+	d.count()
+	return d
+}
+
 // String returns a set's string representation {e1, e2, e3, ..., eN} with eI
 // in |N.
 func (s *Set) String() string {
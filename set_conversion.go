@@ -0,0 +1,128 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package ints
+
+import (
+	"math/big"
+	"math/bits"
+)
+
+// FromBig constructs a set from given integer's bits, i.e. i is an
+// element of returned set iff bit i of given integer is set.  The sign
+// of given integer is ignored.
+func FromBig(b *big.Int) *Set {
+	s, ww := &Set{}, b.Bits()
+	s.words = make([]uint, len(ww))
+	for i, w := range ww { // a big.Word is an uint like a set's word
+		s.words[i] = uint(w)
+	}
+	s.count()
+	return s
+}
+
+// Big returns receiving set as integer whose bit i is set iff i is an
+// element of the set.
+func (s *Set) Big() *big.Int {
+	ww := make([]big.Word, len(s.words))
+	for i, w := range s.words {
+		ww[i] = big.Word(w)
+	}
+	return (&big.Int{}).SetBits(ww)
+}
+
+// FromMask constructs a set whose elements are the set bits of given
+// mask.
+func FromMask(mask uint64) *Set {
+	return FromWords([]uint64{mask})
+}
+
+// Mask returns receiving set as bit-mask whose bit i is set iff i is an
+// element of the set.  ok is false if the set has an element greater
+// than 63.
+func (s *Set) Mask() (mask uint64, ok bool) {
+	ww := s.Words()
+	switch len(ww) {
+	case 0:
+		return 0, true
+	case 1:
+		return ww[0], true
+	}
+	return 0, false
+}
+
+// FromWords constructs a set from given little-endian words, i.e. i is
+// an element of returned set iff bit i%64 of word i/64 is set.
+func FromWords(ww []uint64) *Set {
+	s := &Set{}
+	for i, w := range ww {
+		s.addWord(i*64, w)
+	}
+	return s
+}
+
+// Words returns receiving set's bits as little-endian words, i.e. bit
+// i%64 of word i/64 is set iff i is an element of the set.  Trailing
+// zero words are omitted hence the empty set has no words.
+func (s *Set) Words() []uint64 {
+	last := -1
+	for i, w := range s.words {
+		if w != 0 {
+			last = i
+		}
+	}
+	if last < 0 {
+		return nil
+	}
+	n := ((last+1)*wordLength + 63) / 64
+	ww := make([]uint64, n)
+	for i, w := range s.words[:last+1] {
+		bit := i * wordLength
+		ww[bit/64] |= uint64(w) << (bit % 64)
+	}
+	return ww
+}
+
+// FromBools constructs a set whose elements are the indices of given
+// booleans which are true.
+func FromBools(bb []bool) *Set {
+	s := &Set{}
+	for i, b := range bb {
+		if b {
+			s.add(i)
+		}
+	}
+	return s
+}
+
+// Bools returns a slice of booleans whose i-th value is true iff i is
+// an element of receiving set.  The slice's length is the set's
+// greatest element plus one.
+func (s *Set) Bools() []bool {
+	var bb []bool
+	s.For(func(elm int) {
+		for len(bb) < elm {
+			bb = append(bb, false)
+		}
+		bb = append(bb, true)
+	})
+	return bb
+}
+
+// addWord adds the set bits of given word w with bit i representing
+// element off+i.
+func (s *Set) addWord(off int, w uint64) {
+	for w != 0 {
+		s.add(off + bits.TrailingZeros64(w))
+		w &= w - 1
+	}
+}
+
+// count recalculates the set's cardinality from its words.
+func (s *Set) count() {
+	s.cardinality = 0
+	for _, w := range s.words {
+		s.cardinality += bits.OnesCount(w)
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package ints
+
+import (
+	"math/big"
+	"testing"
+
+	. "github.com/slukits/gounit"
+)
+
+type setConversion struct{ Suite }
+
+func (s *setConversion) SetUp(t *T) { t.Parallel() }
+
+func (s *setConversion) Big_int_has_bit_i_set_for_element_i(t *T) {
+	b := FromSlice([]int{0, 3, 64, 130}).Big()
+	exp := (&big.Int{}).SetBit(&big.Int{}, 0, 1)
+	for _, i := range []int{3, 64, 130} {
+		exp.SetBit(exp, i, 1)
+	}
+	t.Eq(0, exp.Cmp(b))
+	t.Eq(0, (&Set{}).Big().Sign())
+}
+
+func (s *setConversion) From_big_int_has_elements_of_set_bits(t *T) {
+	b, _ := (&big.Int{}).SetString("100000000000000000000000000005", 16)
+	st := FromBig(b)
+	t.Eq("{0, 2, 116}", st.String())
+	t.Eq(3, st.Len())
+	t.True(FromBig(st.Big()).Eq(st))
+}
+
+func (s *setConversion) Mask_fails_if_an_element_exceeds_63(t *T) {
+	mask, ok := FromSlice([]int{0, 5, 63}).Mask()
+	t.True(ok)
+	t.Eq(uint64(1|1<<5|1<<63), mask)
+	_, ok = FromSlice([]int{1, 64}).Mask()
+	t.Not.True(ok)
+	mask, ok = FromSlice([]int{1, 64}).Del(64).Mask()
+	t.True(ok)
+	t.Eq(uint64(2), mask)
+}
+
+func (s *setConversion) From_mask_has_elements_of_set_bits(t *T) {
+	t.Eq("{1, 4, 63}", FromMask(1<<1|1<<4|1<<63).String())
+	t.True(FromMask(0).IsEmpty())
+}
+
+func (s *setConversion) Words_are_little_endian_without_trailing_zeros(
+	t *T,
+) {
+	st := FromSlice([]int{1, 65, 200})
+	t.Eq([]uint64{2, 2, 0, 1 << 8}, st.Words())
+	t.True(FromWords(st.Words()).Eq(st))
+	t.Eq(0, len(FromSlice([]int{70}).Del(70).Words()))
+}
+
+func (s *setConversion) Bools_are_true_at_elements(t *T) {
+	t.Eq([]bool{false, true, false, true}, FromSlice([]int{1, 3}).Bools())
+	t.Eq("{0, 2}", FromBools([]bool{true, false, true, false}).String())
+	t.Eq(0, len((&Set{}).Bools()))
+}
+
+func TestSetConversion(t *testing.T) {
+	t.Parallel()
+	Run(&setConversion{}, t)
+}
@@ -0,0 +1,218 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package ints
+
+import "sort"
+
+// SetFamily stores many sets and answers subset and superset queries
+// efficiently.  It is backed by a set-trie whose paths are the stored
+// sets' ascending elements.  A stored set is identified by the id
+// returned by [SetFamily.Add] and queries return the [Set] of ids of
+// the matching stored sets.  The zero value is ready to use.
+type SetFamily struct {
+	root trieNode
+	sets []*Set
+}
+
+type trieNode struct {
+	elm      int
+	id       int // -1 if no stored set ends at this node
+	children []*trieNode
+}
+
+// child returns the child node of given element or nil.
+func (n *trieNode) child(elm int) *trieNode {
+	i := sort.Search(len(n.children), func(i int) bool {
+		return n.children[i].elm >= elm
+	})
+	if i < len(n.children) && n.children[i].elm == elm {
+		return n.children[i]
+	}
+	return nil
+}
+
+func (n *trieNode) addChild(elm int) *trieNode {
+	i := sort.Search(len(n.children), func(i int) bool {
+		return n.children[i].elm >= elm
+	})
+	if i < len(n.children) && n.children[i].elm == elm {
+		return n.children[i]
+	}
+	c := &trieNode{elm: elm, id: -1}
+	n.children = append(n.children, nil)
+	copy(n.children[i+1:], n.children[i:])
+	n.children[i] = c
+	return c
+}
+
+// Add stores a copy of given set and returns its id.  Adding a set
+// equal to an already stored set returns the stored set's id.
+func (f *SetFamily) Add(s *Set) int {
+	if len(f.sets) == 0 {
+		f.root.id = -1
+	}
+	n := &f.root
+	s.For(func(elm int) { n = n.addChild(elm) })
+	if n.id < 0 {
+		n.id = len(f.sets)
+		f.sets = append(f.sets, s.copy())
+	}
+	return n.id
+}
+
+// Len returns the number of distinct stored sets.
+func (f *SetFamily) Len() int { return len(f.sets) }
+
+// Get returns a copy of the stored set with given id or nil if there
+// is no such set.
+func (f *SetFamily) Get(id int) *Set {
+	if id < 0 || id >= len(f.sets) {
+		return nil
+	}
+	return f.sets[id].copy()
+}
+
+// Id returns the id of the stored set equal to given set; ok is false
+// if there is no such set.
+func (f *SetFamily) Id(s *Set) (id int, ok bool) {
+	if len(f.sets) == 0 {
+		return 0, false
+	}
+	n := &f.root
+	s.For(func(elm int) {
+		if n != nil {
+			n = n.child(elm)
+		}
+	})
+	if n == nil || n.id < 0 {
+		return 0, false
+	}
+	return n.id, true
+}
+
+// Subsets returns the ids of the stored sets which are subsets of given
+// set q.
+func (f *SetFamily) Subsets(q *Set) *Set {
+	ids := &Set{}
+	if len(f.sets) > 0 {
+		f.root.subsets(q.ToSlice(), func(id int) bool {
+			ids.add(id)
+			return true
+		})
+	}
+	return ids
+}
+
+// HasSubset returns true if a stored set is a subset of given set q.
+func (f *SetFamily) HasSubset(q *Set) bool {
+	found := false
+	if len(f.sets) > 0 {
+		f.root.subsets(q.ToSlice(), func(int) bool {
+			found = true
+			return false
+		})
+	}
+	return found
+}
+
+// subsets reports the ids of stored sets in the sub-trie of receiving
+// node whose remaining elements are in given ascending elements qq as
+// long as report returns true.
+func (n *trieNode) subsets(qq []int, report func(int) bool) bool {
+	if n.id >= 0 && !report(n.id) {
+		return false
+	}
+	for i, elm := range qq {
+		c := n.child(elm)
+		if c == nil {
+			continue
+		}
+		if !c.subsets(qq[i+1:], report) {
+			return false
+		}
+	}
+	return true
+}
+
+// Supersets returns the ids of the stored sets which are supersets of
+// given set q.
+func (f *SetFamily) Supersets(q *Set) *Set {
+	ids := &Set{}
+	if len(f.sets) > 0 {
+		f.root.supersets(q.ToSlice(), func(id int) bool {
+			ids.add(id)
+			return true
+		})
+	}
+	return ids
+}
+
+// HasSuperset returns true if a stored set contains given set q.
+func (f *SetFamily) HasSuperset(q *Set) bool {
+	found := false
+	if len(f.sets) > 0 {
+		f.root.supersets(q.ToSlice(), func(int) bool {
+			found = true
+			return false
+		})
+	}
+	return found
+}
+
+// supersets reports the ids of stored sets in the sub-trie of receiving
+// node which contain given ascending elements qq as long as report
+// returns true.
+func (n *trieNode) supersets(qq []int, report func(int) bool) bool {
+	if len(qq) == 0 && n.id >= 0 && !report(n.id) {
+		return false
+	}
+	for _, c := range n.children {
+		if len(qq) > 0 && c.elm > qq[0] {
+			break
+		}
+		rest := qq
+		if len(qq) > 0 && c.elm == qq[0] {
+			rest = qq[1:]
+		}
+		if !c.supersets(rest, report) {
+			return false
+		}
+	}
+	return true
+}
+
+// Minimal returns the ids of the stored sets which have no other stored
+// set as subset.
+func (f *SetFamily) Minimal() *Set {
+	ids := &Set{}
+	for id, s := range f.sets {
+		proper := false
+		f.root.subsets(s.ToSlice(), func(other int) bool {
+			proper = other != id
+			return !proper
+		})
+		if !proper {
+			ids.add(id)
+		}
+	}
+	return ids
+}
+
+// Maximal returns the ids of the stored sets which are no subset of
+// another stored set.
+func (f *SetFamily) Maximal() *Set {
+	ids := &Set{}
+	for id, s := range f.sets {
+		proper := false
+		f.root.supersets(s.ToSlice(), func(other int) bool {
+			proper = other != id
+			return !proper
+		})
+		if !proper {
+			ids.add(id)
+		}
+	}
+	return ids
+}
@@ -0,0 +1,79 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package ints
+
+import (
+	"testing"
+
+	. "github.com/slukits/gounit"
+)
+
+type setFamily struct{ Suite }
+
+func (s *setFamily) SetUp(t *T) { t.Parallel() }
+
+// family returns a family with the sets {1, 2}, {1, 2, 3}, {2, 4},
+// {3} and {1, 2, 3, 4, 5} having the ids 0 to 4.
+func family() *SetFamily {
+	f := &SetFamily{}
+	for _, ee := range [][]int{{1, 2}, {1, 2, 3}, {2, 4}, {3},
+		{1, 2, 3, 4, 5}} {
+		f.Add(FromSlice(ee))
+	}
+	return f
+}
+
+func (s *setFamily) Is_initially_empty(t *T) {
+	var fx SetFamily
+	t.Eq(0, fx.Len())
+	t.True(fx.Subsets(FromSlice([]int{1})).IsEmpty())
+	t.True(fx.Supersets(&Set{}).IsEmpty())
+	t.Not.True(fx.HasSubset(&Set{}))
+	_, ok := fx.Id(&Set{})
+	t.Not.True(ok)
+}
+
+func (s *setFamily) Identifies_stored_sets(t *T) {
+	f := family()
+	t.Eq(5, f.Len())
+	t.Eq(1, f.Add(FromSlice([]int{3, 2, 1})))
+	t.Eq(5, f.Len())
+	id, ok := f.Id(FromSlice([]int{2, 4}))
+	t.True(ok)
+	t.Eq(2, id)
+	_, ok = f.Id(FromSlice([]int{2}))
+	t.Not.True(ok)
+	t.Eq("{2, 4}", f.Get(2).String())
+	t.True(f.Get(5) == nil)
+}
+
+func (s *setFamily) Finds_stored_subsets_of_query(t *T) {
+	f := family()
+	t.Eq("{0, 1, 3}", f.Subsets(FromSlice([]int{1, 2, 3})).String())
+	t.Eq("{}", f.Subsets(FromSlice([]int{4, 5})).String())
+	t.True(f.HasSubset(FromSlice([]int{2, 4, 7})))
+	t.Not.True(f.HasSubset(FromSlice([]int{1, 4})))
+	f.Add(&Set{})
+	t.Eq("{5}", f.Subsets(FromSlice([]int{4, 5})).String())
+}
+
+func (s *setFamily) Finds_stored_supersets_of_query(t *T) {
+	f := family()
+	t.Eq("{1, 4}", f.Supersets(FromSlice([]int{1, 3})).String())
+	t.Eq("{0, 1, 2, 3, 4}", f.Supersets(&Set{}).String())
+	t.True(f.HasSuperset(FromSlice([]int{4, 5})))
+	t.Not.True(f.HasSuperset(FromSlice([]int{6})))
+}
+
+func (s *setFamily) Provides_minimal_and_maximal_sets(t *T) {
+	f := family()
+	t.Eq("{0, 2, 3}", f.Minimal().String())
+	t.Eq("{4}", f.Maximal().String())
+}
+
+func TestSetFamily(t *testing.T) {
+	t.Parallel()
+	Run(&setFamily{}, t)
+}
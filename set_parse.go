@@ -0,0 +1,194 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package ints
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// SetSyntaxError is returned by [ParseSet] if given string is not a
+// valid set description.  Pos is the byte offset of the problem in the
+// parsed string.
+type SetSyntaxError struct {
+	Pos int
+	Msg string
+}
+
+func (e *SetSyntaxError) Error() string {
+	return fmt.Sprintf("ints: set: parse: position %d: %s", e.Pos, e.Msg)
+}
+
+// ParseSet parses given string into a set.  The grammar is
+//
+//	list  = [ item { "," item } ]
+//	item  = [ "^" ] range
+//	range = int [ "-" int [ ":" step ] ]
+//
+// with int a non-negative decimal integer and step a positive one.
+// "lo-hi" describes all integers from lo to hi inclusive, "lo-hi:step"
+// every step-th of them starting at lo.  An item prefixed by "^" is an
+// exclusion, i.e. its elements are removed from the set after all
+// other items have been added.  Whitespace around tokens is ignored.
+// E.g. "0-20:5, 8, ^10" is parsed to {0, 5, 8, 15, 20}.  An integer
+// may not exceed 16777215.  ParseSet fails with a [*SetSyntaxError]
+// reporting the position of the first problem.
+func ParseSet(s string) (*Set, error) {
+	return parseSet(s, maxParsedElement)
+}
+
+// parseSet parses given string into a set whose elements may not
+// exceed given max.
+func parseSet(s string, max int) (*Set, error) {
+	p := &setParser{s: s, max: max}
+	set, excl := &Set{}, &Set{}
+	p.skipSpace()
+	if p.done() {
+		return set, nil
+	}
+	for {
+		exclude := p.accept('^')
+		lo, hi, step, err := p.parseRange()
+		if err != nil {
+			return nil, err
+		}
+		target := set
+		if exclude {
+			target = excl
+		}
+		if step == 1 {
+			target.addRange(lo, hi+1)
+		}
+		for e := lo; step > 1; e += step {
+			target.add(e)
+			if hi-e < step {
+				break
+			}
+		}
+		if p.done() {
+			break
+		}
+		if !p.accept(',') {
+			return nil, p.errorf("expected ',' got %q", p.s[p.pos])
+		}
+	}
+	excl.For(set.del)
+	return set, nil
+}
+
+// maxParsedElement bounds parsed elements to keep a typo from
+// allocating more than 2 MiB for a parsed set.
+const maxParsedElement = 1<<24 - 1
+
+type setParser struct {
+	s        string
+	pos, max int
+}
+
+func (p *setParser) errorf(format string, args ...interface{}) error {
+	return &SetSyntaxError{Pos: p.pos, Msg: fmt.Sprintf(format, args...)}
+}
+
+func (p *setParser) skipSpace() {
+	for p.pos < len(p.s) && strings.ContainsRune(" \t\n\r", rune(
+		p.s[p.pos])) {
+		p.pos++
+	}
+}
+
+func (p *setParser) done() bool { return p.pos >= len(p.s) }
+
+// accept consumes given byte and following whitespace if it is the
+// next byte.
+func (p *setParser) accept(b byte) bool {
+	if p.done() || p.s[p.pos] != b {
+		return false
+	}
+	p.pos++
+	p.skipSpace()
+	return true
+}
+
+func (p *setParser) parseRange() (lo, hi, step int, err error) {
+	start := p.pos
+	if lo, err = p.parseInt(); err != nil {
+		return 0, 0, 0, err
+	}
+	if !p.accept('-') {
+		return lo, lo, 1, nil
+	}
+	if hi, err = p.parseInt(); err != nil {
+		return 0, 0, 0, err
+	}
+	if hi < lo {
+		p.pos = start
+		return 0, 0, 0, p.errorf("range start %d exceeds end %d", lo, hi)
+	}
+	if !p.accept(':') {
+		return lo, hi, 1, nil
+	}
+	stepPos := p.pos
+	if step, err = p.parseInt(); err != nil {
+		return 0, 0, 0, err
+	}
+	if step == 0 {
+		p.pos = stepPos
+		return 0, 0, 0, p.errorf("step must be positive")
+	}
+	return lo, hi, step, nil
+}
+
+func (p *setParser) parseInt() (int, error) {
+	start := p.pos
+	for p.pos < len(p.s) && '0' <= p.s[p.pos] && p.s[p.pos] <= '9' {
+		p.pos++
+	}
+	if start == p.pos {
+		if p.done() {
+			return 0, p.errorf("expected integer got end of input")
+		}
+		return 0, p.errorf("expected integer got %q", p.s[p.pos])
+	}
+	i, err := strconv.ParseUint(p.s[start:p.pos], 10, 64)
+	if err != nil || i > uint64(p.max) {
+		num := p.s[start:p.pos]
+		p.pos = start
+		return 0, p.errorf("integer %s out of range", num)
+	}
+	p.skipSpace()
+	return int(i), nil
+}
+
+// RangeString returns receiving set's compact representation which is
+// parsed by [ParseSet], i.e. runs of two or more consecutive elements
+// are written as "lo-hi" and the runs are separated by commas, e.g.
+// "0-3,8,10-11".
+func (s *Set) RangeString() string {
+	var rr []string
+	s.forRuns(func(lo, hi int) {
+		if hi-lo == 1 {
+			rr = append(rr, strconv.Itoa(lo))
+			return
+		}
+		rr = append(rr, strconv.Itoa(lo)+"-"+strconv.Itoa(hi-1))
+	})
+	return strings.Join(rr, ",")
+}
+
+// Set implements the [flag.Value] interface, i.e. it replaces the
+// elements of receiving set with the elements parsed by [ParseSet] from
+// given string.  Hence a set may be used as command line flag
+//
+//	cpus := &ints.Set{}
+//	flag.Var(cpus, "cpus", "cpus to use, e.g. 0-3,8,10-11")
+func (s *Set) Set(value string) error {
+	parsed, err := ParseSet(value)
+	if err != nil {
+		return err
+	}
+	*s = *parsed
+	return nil
+}
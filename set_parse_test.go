@@ -0,0 +1,106 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package ints
+
+import (
+	"errors"
+	"flag"
+	"io"
+	"testing"
+
+	. "github.com/slukits/gounit"
+)
+
+type parse struct{ Suite }
+
+func (s *parse) SetUp(t *T) { t.Parallel() }
+
+func (s *parse) Empty_string_is_empty_set(t *T) {
+	for _, str := range []string{"", "  "} {
+		st, err := ParseSet(str)
+		t.FatalOn(err)
+		t.True(st.IsEmpty())
+	}
+}
+
+func (s *parse) Ranges_and_single_elements(t *T) {
+	st, err := ParseSet("0-3,8,10-11")
+	t.FatalOn(err)
+	t.Eq("{0, 1, 2, 3, 8, 10, 11}", st.String())
+}
+
+func (s *parse) Ranges_with_step(t *T) {
+	st, err := ParseSet("0-20:5")
+	t.FatalOn(err)
+	t.Eq("{0, 5, 10, 15, 20}", st.String())
+}
+
+func (s *parse) Ranges_up_to_max_element(t *T) {
+	st, err := ParseSet("60-130, 16777215")
+	t.FatalOn(err)
+	t.Eq(72, st.Len())
+	t.True(st.Has(60, 63, 64, 127, 128, 130, 16777215))
+	t.Not.True(st.Has(59, 131))
+	st, err = ParseSet("0-16777215")
+	t.FatalOn(err)
+	t.Eq(1<<24, st.Len())
+}
+
+func (s *parse) Exclusions_after_inclusions(t *T) {
+	st, err := ParseSet("^3, 0-5, ^4-10")
+	t.FatalOn(err)
+	t.Eq("{0, 1, 2}", st.String())
+}
+
+func (s *parse) Tolerates_whitespace(t *T) {
+	st, err := ParseSet(" 0 - 4 : 2 ,\t^ 2 , 7 ")
+	t.FatalOn(err)
+	t.Eq("{0, 4, 7}", st.String())
+}
+
+func (s *parse) Reports_position_of_problem(t *T) {
+	for str, pos := range map[string]int{
+		"1,,2":          2,
+		"1,":            2,
+		"1-":            2,
+		"5-3":           0,
+		"1 2":           2,
+		"0-9:0":         4,
+		"0-9:x":         4,
+		"1,a":           2,
+		"9999999999999": 0,
+		"0-2147483647":  2,
+		"1,16777216":    2,
+	} {
+		_, err := ParseSet(str)
+		var serr *SetSyntaxError
+		t.True(errors.As(err, &serr))
+		t.Eq(pos, serr.Pos)
+	}
+}
+
+func (s *parse) Range_string_is_compact_and_parsable(t *T) {
+	st := FromSlice([]int{0, 1, 2, 3, 8, 10, 11})
+	t.Eq("0-3,8,10-11", st.RangeString())
+	t.Eq("", (&Set{}).RangeString())
+	parsed, err := ParseSet(st.RangeString())
+	t.FatalOn(err)
+	t.True(parsed.Eq(st))
+}
+
+func (s *parse) Set_is_a_flag_value(t *T) {
+	cpus := FromSlice([]int{42})
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	fs.Var(cpus, "cpus", "cpus to use")
+	t.FatalOn(fs.Parse([]string{"-cpus", "0-3,8"}))
+	t.Eq("{0, 1, 2, 3, 8}", cpus.String())
+	t.Err(fs.Parse([]string{"-cpus", "0-"}))
+}
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+	Run(&parse{}, t)
+}
@@ -0,0 +1,248 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package ints
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+// ErrInvalidPatch is returned by [SetPatch.UnmarshalBinary] if given
+// bytes are not a valid patch encoding.
+var ErrInvalidPatch = errors.New("ints: set: patch: invalid encoding")
+
+// SetPatch represents the delta between two sets, i.e. the elements
+// which were added and the elements which were removed.  Create a
+// patch by calling [Diff] and apply it to a replica by [Set.Apply].
+// The zero value is an empty patch.
+type SetPatch struct {
+	added, removed Set
+	max            int
+}
+
+// Diff returns the patch which transforms given old set into given new
+// set.
+func Diff(old, new *Set) *SetPatch {
+	p := &SetPatch{}
+	new.For(func(elm int) {
+		if !old.has(elm) {
+			p.added.add(elm)
+		}
+	})
+	old.For(func(elm int) {
+		if !new.has(elm) {
+			p.removed.add(elm)
+		}
+	})
+	return p
+}
+
+// Added returns the elements added by given patch.
+func (p *SetPatch) Added() []int { return p.added.ToSlice() }
+
+// Removed returns the elements removed by given patch.
+func (p *SetPatch) Removed() []int { return p.removed.ToSlice() }
+
+// IsEmpty returns true if given patch doesn't change a set.
+func (p *SetPatch) IsEmpty() bool {
+	return p.added.IsEmpty() && p.removed.IsEmpty()
+}
+
+// Invert returns the patch which undoes given patch, i.e. applying a
+// patch and then its inverse to a set leaves the set unchanged.
+func (p *SetPatch) Invert() *SetPatch {
+	inv := &SetPatch{}
+	inv.added.Add(p.removed.ToSlice()...)
+	inv.removed.Add(p.added.ToSlice()...)
+	return inv
+}
+
+// Apply applies given patch to receiving set, i.e. removes the patch's
+// removed elements and adds its added elements.
+func (s *Set) Apply(p *SetPatch) *Set {
+	p.removed.For(s.del)
+	p.added.For(s.add)
+	return s
+}
+
+const (
+	patchRuns byte = iota
+	patchBitmap
+)
+
+// MarshalBinary encodes given patch.  Added and removed elements are
+// each encoded either as a list of runs of consecutive elements or as
+// the bitmap of the words they span whichever is smaller.
+func (p *SetPatch) MarshalBinary() ([]byte, error) {
+	return p.removed.appendPatchEncoding(
+		p.added.appendPatchEncoding(nil)), nil
+}
+
+// MaxElement sets the greatest element [SetPatch.UnmarshalBinary]
+// decodes; n smaller than one restores the default
+// [DefaultMaxSetElement].
+func (p *SetPatch) MaxElement(n int) *SetPatch {
+	p.max = n
+	return p
+}
+
+// UnmarshalBinary replaces given patch with the decoded patch of given
+// bytes.  It fails with an [ErrInvalidPatch] error if given bytes are
+// not a valid encoding or if they decode to an element greater than
+// the patch's maximal element (see [SetPatch.MaxElement]).
+func (p *SetPatch) UnmarshalBinary(bb []byte) (err error) {
+	var added, removed Set
+	max := maxSetElement(p.max)
+	if bb, err = added.decodePatchEncoding(bb, max); err != nil {
+		return err
+	}
+	if bb, err = removed.decodePatchEncoding(bb, max); err != nil {
+		return err
+	}
+	if len(bb) > 0 {
+		return ErrInvalidPatch
+	}
+	p.added, p.removed = added, removed
+	return nil
+}
+
+func (s *Set) appendPatchEncoding(bb []byte) []byte {
+	runs := s.appendRuns([]byte{patchRuns})
+	bitmap := s.appendBitmap([]byte{patchBitmap})
+	if len(bitmap) < len(runs) {
+		return append(bb, bitmap...)
+	}
+	return append(bb, runs...)
+}
+
+// appendRuns appends the number of runs and for each run the gap to
+// the previous run and its length minus one.
+func (s *Set) appendRuns(bb []byte) []byte {
+	var rr [][2]int
+	s.forRuns(func(lo, hi int) { rr = append(rr, [2]int{lo, hi}) })
+	bb = appendUvarint(bb, uint64(len(rr)))
+	end := 0
+	for _, r := range rr {
+		bb = appendUvarint(bb, uint64(r[0]-end))
+		bb = appendUvarint(bb, uint64(r[1]-r[0]-1))
+		end = r[1]
+	}
+	return bb
+}
+
+// appendBitmap appends the offset of the first non-zero byte of the
+// set's little-endian bitmap, the number of following bytes up to the
+// last non-zero byte and these bytes.
+func (s *Set) appendBitmap(bb []byte) []byte {
+	first, last := -1, -1
+	for i := 0; i < len(s.words)*wordBytes; i++ {
+		if s.byteAt(i) == 0 {
+			continue
+		}
+		if first < 0 {
+			first = i
+		}
+		last = i
+	}
+	if first < 0 {
+		return appendUvarint(appendUvarint(bb, 0), 0)
+	}
+	bb = appendUvarint(bb, uint64(first))
+	bb = appendUvarint(bb, uint64(last-first+1))
+	for i := first; i <= last; i++ {
+		bb = append(bb, s.byteAt(i))
+	}
+	return bb
+}
+
+const wordBytes = wordLength / 8
+
+func (s *Set) byteAt(i int) byte {
+	return byte(s.words[i/wordBytes] >> (8 * uint(i%wordBytes)))
+}
+
+// decodePatchEncoding adds the elements of given encoding and returns
+// the bytes following it.  It fails if an element exceeds given max
+// which bounds the memory a decoded set allocates since runs are
+// decoded in constant space.
+func (s *Set) decodePatchEncoding(bb []byte, max uint64) ([]byte, error) {
+	if len(bb) == 0 {
+		return nil, ErrInvalidPatch
+	}
+	kind, bb := bb[0], bb[1:]
+	switch kind {
+	case patchRuns:
+		n, bb, err := uvarint(bb)
+		if err != nil {
+			return nil, err
+		}
+		end := uint64(0)
+		for i := uint64(0); i < n; i++ {
+			var gap, ln uint64
+			if gap, bb, err = uvarint(bb); err != nil {
+				return nil, err
+			}
+			if ln, bb, err = uvarint(bb); err != nil {
+				return nil, err
+			}
+			if gap > max || ln > max || end+gap+ln > max {
+				return nil, ErrInvalidPatch
+			}
+			lo, hi := end+gap, end+gap+ln+1
+			s.addRange(int(lo), int(hi))
+			end = hi
+		}
+		return bb, nil
+	case patchBitmap:
+		off, bb, err := uvarint(bb)
+		if err != nil {
+			return nil, err
+		}
+		n, bb, err := uvarint(bb)
+		if err != nil {
+			return nil, err
+		}
+		if n > uint64(len(bb)) || off > max/8 || (off+n)*8 > max+1 {
+			return nil, ErrInvalidPatch
+		}
+		for i, b := range bb[:n] {
+			for bit := 0; b != 0; bit++ {
+				if b&1 != 0 {
+					s.add(int(off+uint64(i))*8 + bit)
+				}
+				b >>= 1
+			}
+		}
+		return bb[n:], nil
+	}
+	return nil, ErrInvalidPatch
+}
+
+// DefaultMaxSetElement is the default of the greatest element a
+// decoded [SetPatch] or a [SetDecoder]'s set may have which keeps a
+// corrupt or hostile encoding from allocating more than 2 MiB per set.
+const DefaultMaxSetElement = 1<<24 - 1
+
+// maxSetElement returns given maximal element or the default if n is
+// smaller than one.
+func maxSetElement(n int) uint64 {
+	if n < 1 {
+		return DefaultMaxSetElement
+	}
+	return uint64(n)
+}
+
+func appendUvarint(bb []byte, v uint64) []byte {
+	var buf [binary.MaxVarintLen64]byte
+	return append(bb, buf[:binary.PutUvarint(buf[:], v)]...)
+}
+
+func uvarint(bb []byte) (uint64, []byte, error) {
+	v, n := binary.Uvarint(bb)
+	if n <= 0 {
+		return 0, nil, ErrInvalidPatch
+	}
+	return v, bb[n:], nil
+}
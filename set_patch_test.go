@@ -0,0 +1,132 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package ints
+
+import (
+	"testing"
+
+	. "github.com/slukits/gounit"
+)
+
+type patch struct{ Suite }
+
+func (s *patch) SetUp(t *T) { t.Parallel() }
+
+func (s *patch) Has_added_and_removed_elements(t *T) {
+	p := Diff(FromSlice([]int{1, 2, 3}), FromSlice([]int{2, 3, 4, 5}))
+	t.Eq([]int{4, 5}, p.Added())
+	t.Eq([]int{1}, p.Removed())
+}
+
+func (s *patch) Of_equal_sets_is_empty(t *T) {
+	t.True(Diff(FromSlice([]int{1, 2}), FromSlice([]int{1, 2})).IsEmpty())
+	t.True((&SetPatch{}).IsEmpty())
+}
+
+func (s *patch) Transforms_old_into_new_set(t *T) {
+	old, new := FromSlice([]int{1, 64, 65, 300}), FromSlice(
+		[]int{0, 64, 500, 501, 502})
+	t.True(FromSlice(old.ToSlice()).Apply(Diff(old, new)).Eq(new))
+}
+
+func (s *patch) Is_undone_by_its_inverse(t *T) {
+	old, new := FromSlice([]int{1, 64, 65, 300}), FromSlice(
+		[]int{0, 64, 500, 501, 502})
+	p := Diff(old, new)
+	got := FromSlice(old.ToSlice()).Apply(p).Apply(p.Invert())
+	t.True(got.Eq(old))
+}
+
+func (s *patch) Survives_binary_round_trip(t *T) {
+	dense := &Set{}
+	for i := 0; i < 300; i += 2 {
+		dense.Add(i)
+	}
+	runs := &Set{}
+	for i := 1000; i < 2000; i++ {
+		runs.Add(i)
+	}
+	for _, p := range []*SetPatch{
+		{},
+		Diff(&Set{}, dense),
+		Diff(dense, runs),
+		Diff(FromSlice([]int{1, 7, 8, 9}), FromSlice([]int{7, 8, 4711})),
+	} {
+		bb, err := p.MarshalBinary()
+		t.FatalOn(err)
+		got := &SetPatch{}
+		t.FatalOn(got.UnmarshalBinary(bb))
+		t.Eq(p.Added(), got.Added())
+		t.Eq(p.Removed(), got.Removed())
+	}
+}
+
+func (s *patch) Encodes_runs_compactly(t *T) {
+	runs := &Set{}
+	for i := 1000; i < 2000; i++ {
+		runs.Add(i)
+	}
+	bb, err := Diff(&Set{}, runs).MarshalBinary()
+	t.FatalOn(err)
+	t.True(len(bb) < 10)
+}
+
+func (s *patch) Encodes_scattered_elements_as_bitmap(t *T) {
+	dense := &Set{}
+	for i := 0; i < 640; i += 2 {
+		dense.Add(i)
+	}
+	bb, err := Diff(&Set{}, dense).MarshalBinary()
+	t.FatalOn(err)
+	t.Eq(patchBitmap, bb[0])
+	t.True(len(bb) < 100)
+}
+
+func (s *patch) Decoding_fails_on_invalid_encoding(t *T) {
+	bb, err := Diff(FromSlice([]int{1}), FromSlice([]int{2})).
+		MarshalBinary()
+	t.FatalOn(err)
+	for _, invalid := range [][]byte{
+		nil, {42}, bb[:len(bb)-1], append(bb, 0),
+	} {
+		t.ErrIs((&SetPatch{}).UnmarshalBinary(invalid), ErrInvalidPatch)
+	}
+}
+
+func (s *patch) Decodes_runs_across_words(t *T) {
+	runs := FromSlice([]int{3})
+	for i := 60; i < 200; i++ {
+		runs.Add(i)
+	}
+	bb, err := Diff(&Set{}, runs).MarshalBinary()
+	t.FatalOn(err)
+	got := &SetPatch{}
+	t.FatalOn(got.UnmarshalBinary(bb))
+	t.Eq(runs.ToSlice(), got.Added())
+}
+
+func (s *patch) Decoding_bounds_elements(t *T) {
+	hostile := appendUvarint([]byte{patchRuns, 1, 0}, 1<<28)
+	hostile = append(hostile, patchRuns, 0)
+	t.ErrIs((&SetPatch{}).UnmarshalBinary(hostile), ErrInvalidPatch)
+	bitmap := appendUvarint([]byte{patchBitmap}, 1<<40)
+	bitmap = append(bitmap, 1, 1, patchRuns, 0)
+	t.ErrIs((&SetPatch{}).UnmarshalBinary(bitmap), ErrInvalidPatch)
+
+	p := (&SetPatch{}).MaxElement(1 << 20)
+	t.ErrIs(p.UnmarshalBinary(hostile), ErrInvalidPatch)
+	hostile = appendUvarint([]byte{patchRuns, 1, 0}, 1<<20+1)
+	hostile = append(hostile, patchRuns, 0)
+	t.ErrIs(p.UnmarshalBinary(hostile), ErrInvalidPatch)
+	hostile = appendUvarint([]byte{patchRuns, 1, 0}, 1<<20)
+	hostile = append(hostile, patchRuns, 0)
+	t.FatalOn(p.UnmarshalBinary(hostile))
+	t.Eq(1<<20+1, len(p.Added()))
+}
+
+func TestPatch(t *testing.T) {
+	t.Parallel()
+	Run(&patch{}, t)
+}
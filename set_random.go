@@ -0,0 +1,109 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package ints
+
+import (
+	"math/bits"
+	"math/rand"
+	"sort"
+)
+
+// RandomElement returns a uniformly chosen element of receiving set
+// using given random number generator.  ok is false iff the set is
+// empty.  The element is located by the set's per-word population
+// counts, i.e. no slice of the set's elements is materialized.
+func (s *Set) RandomElement(rng *rand.Rand) (elm int, ok bool) {
+	if s.IsEmpty() {
+		return 0, false
+	}
+	return s.nth(rng.Intn(s.Len())), true
+}
+
+// Sample returns a new set of k distinct uniformly chosen elements of
+// receiving set using given random number generator.  Is k greater or
+// equal the set's cardinality a copy of the set is returned; is k
+// smaller than one the returned set is empty.
+func (s *Set) Sample(k int, rng *rand.Rand) *Set {
+	smp, n := &Set{}, s.Len()
+	if k <= 0 {
+		return smp
+	}
+	if k >= n {
+		return smp.Add(s.ToSlice()...)
+	}
+
+	// Floyd's algorithm picks k distinct ranks out of [0, n)
+	ranks := map[int]bool{}
+	for j := n - k; j < n; j++ {
+		r := rng.Intn(j + 1)
+		if ranks[r] {
+			r = j
+		}
+		ranks[r] = true
+	}
+	rr := make([]int, 0, k)
+	for r := range ranks {
+		rr = append(rr, r)
+	}
+	sort.Ints(rr)
+
+	s.forRanks(rr, func(elm int) { smp.add(elm) })
+	return smp
+}
+
+// RandomSubset returns a new set containing each element of receiving
+// set independently with probability p using given random number
+// generator.  p <= 0 returns the empty set, p >= 1 a copy.
+func (s *Set) RandomSubset(p float64, rng *rand.Rand) *Set {
+	sub := &Set{}
+	if p <= 0 {
+		return sub
+	}
+	s.For(func(elm int) {
+		if p >= 1 || rng.Float64() < p {
+			sub.add(elm)
+		}
+	})
+	return sub
+}
+
+// nth returns the element of given rank n, i.e. the n-th smallest
+// element starting at zero.  nth expects 0 <= n < s.Len().
+func (s *Set) nth(n int) int {
+	for idx, word := range s.words {
+		cnt := bits.OnesCount(word)
+		if n >= cnt {
+			n -= cnt
+			continue
+		}
+		return idx*wordLength + selectBit(word, n)
+	}
+	panic("ints: set: nth: rank out of range")
+}
+
+// forRanks calls back for each element whose rank is in given
+// ascending sorted ranks.
+func (s *Set) forRanks(rr []int, cb func(int)) {
+	base := 0
+	for idx, word := range s.words {
+		cnt := bits.OnesCount(word)
+		for len(rr) > 0 && rr[0] < base+cnt {
+			cb(idx*wordLength + selectBit(word, rr[0]-base))
+			rr = rr[1:]
+		}
+		if len(rr) == 0 {
+			return
+		}
+		base += cnt
+	}
+}
+
+// selectBit returns the position of given word's n-th set bit.
+func selectBit(word uint, n int) int {
+	for ; n > 0; n-- {
+		word &= word - 1
+	}
+	return bits.TrailingZeros(word)
+}
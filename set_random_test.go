@@ -0,0 +1,118 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package ints
+
+import (
+	"math/rand"
+	"sync"
+	"testing"
+
+	. "github.com/slukits/gounit"
+)
+
+type random struct{ Suite }
+
+func (s *random) SetUp(t *T) { t.Parallel() }
+
+func (s *random) Element_of_empty_set_is_not_ok(t *T) {
+	_, ok := (&Set{}).RandomElement(rand.New(rand.NewSource(1)))
+	t.Not.True(ok)
+}
+
+func (s *random) Element_is_in_set(t *T) {
+	st, rng := FromSlice([]int{3, 64, 65, 200, 4711}), rand.New(
+		rand.NewSource(42))
+	for i := 0; i < 100; i++ {
+		elm, ok := st.RandomElement(rng)
+		t.True(ok)
+		t.True(st.Has(elm))
+	}
+}
+
+func (s *random) Element_choice_covers_all_elements(t *T) {
+	st, rng, seen := FromSlice([]int{0, 63, 64, 127, 128, 1000}),
+		rand.New(rand.NewSource(42)), &Set{}
+	for i := 0; i < 1000; i++ {
+		elm, _ := st.RandomElement(rng)
+		seen.Add(elm)
+	}
+	t.True(seen.Eq(st))
+}
+
+func (s *random) Element_is_selected_by_rank(t *T) {
+	st := &Set{}
+	for i := 0; i < 5000; i += 7 {
+		st.Add(i)
+	}
+	elms := st.ToSlice()
+	for n := range elms {
+		t.Eq(elms[n], st.nth(n))
+	}
+	st.Del(elms[0]).Add(100_000)
+	elms = st.ToSlice()
+	for n := range elms {
+		t.Eq(elms[n], st.nth(n))
+	}
+}
+
+func (s *random) Element_choice_only_reads_set(t *T) {
+	st, wg := FromSlice([]int{1, 64, 700, 4711}), sync.WaitGroup{}
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func(seed int64) {
+			defer wg.Done()
+			rng := rand.New(rand.NewSource(seed))
+			for j := 0; j < 100; j++ {
+				st.RandomElement(rng)
+			}
+		}(int64(i))
+	}
+	wg.Wait()
+	t.Eq(4, st.Len())
+}
+
+func (s *random) Element_choice_is_reproducible(t *T) {
+	st := FromSlice([]int{1, 2, 3, 100, 200, 300})
+	r1, r2 := rand.New(rand.NewSource(7)), rand.New(rand.NewSource(7))
+	for i := 0; i < 20; i++ {
+		e1, _ := st.RandomElement(r1)
+		e2, _ := st.RandomElement(r2)
+		t.Eq(e1, e2)
+	}
+}
+
+func (s *random) Sample_has_k_distinct_elements_of_set(t *T) {
+	st, rng := (&Set{}), rand.New(rand.NewSource(42))
+	for i := 0; i < 500; i += 3 {
+		st.Add(i)
+	}
+	smp := st.Sample(20, rng)
+	t.Eq(20, smp.Len())
+	t.True(st.HasSub(smp))
+}
+
+func (s *random) Sample_of_at_least_cardinality_copies_set(t *T) {
+	st, rng := FromSlice([]int{1, 70, 140}), rand.New(rand.NewSource(1))
+	t.True(st.Sample(3, rng).Eq(st))
+	t.True(st.Sample(10, rng).Eq(st))
+	t.True(st.Sample(0, rng).IsEmpty())
+}
+
+func (s *random) Subset_is_subset_of_set(t *T) {
+	st, rng := (&Set{}), rand.New(rand.NewSource(42))
+	for i := 0; i < 1000; i++ {
+		st.Add(i)
+	}
+	sub := st.RandomSubset(0.5, rng)
+	t.True(st.HasSub(sub))
+	t.True(sub.Len() > 400 && sub.Len() < 600)
+	t.True(st.RandomSubset(0, rng).IsEmpty())
+	t.True(st.RandomSubset(1, rng).Eq(st))
+}
+
+func TestRandom(t *testing.T) {
+	t.Parallel()
+	Run(&random{}, t)
+}
@@ -0,0 +1,117 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package ints
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"math"
+	"strings"
+)
+
+// Value implements the [driver.Valuer] interface storing a set as its
+// [Set.String] representation, e.g. "{1, 2, 3}", which fits TEXT
+// columns as well as PostgreSQL integer array columns.  A nil set is
+// stored as NULL.  Wrap a set in a [SQLBitmap] to store it in a
+// BLOB/bytea column; [Set.Scan] reads both representations.
+func (s *Set) Value() (driver.Value, error) {
+	if s == nil {
+		return nil, nil
+	}
+	return s.String(), nil
+}
+
+// Scan implements the [sql.Scanner] interface replacing receiving
+// set's elements with the scanned elements.  Text is either an integer
+// array literal like "{1,2,3}" or in the range syntax of [ParseSet]
+// whose elements are not bounded; NULL is scanned as empty set.  Bytes
+// starting with the tag of a [SQLBitmap] are scanned as bitmap, any
+// other bytes as text.
+func (s *Set) Scan(src interface{}) error {
+	var txt string
+	switch src := src.(type) {
+	case nil:
+		*s = Set{}
+		return nil
+	case string:
+		txt = src
+	case []byte:
+		if len(src) > 0 && src[0] == sqlBitmapTag {
+			*s = *bitmapSet(src[1:])
+			return nil
+		}
+		txt = string(src)
+	default:
+		return fmt.Errorf("ints: set: scan: unsupported type %T", src)
+	}
+	txt = strings.TrimSpace(txt)
+	if strings.HasPrefix(txt, "{") && strings.HasSuffix(txt, "}") {
+		txt = txt[1 : len(txt)-1]
+	}
+	parsed, err := parseSet(txt, math.MaxInt)
+	if err != nil {
+		return err
+	}
+	*s = *parsed
+	return nil
+}
+
+// bitmapSet returns the set of given little-endian bitmap.
+func bitmapSet(bb []byte) *Set {
+	s := &Set{}
+	for i, v := range bb {
+		s.addWord(8*i, uint64(v))
+	}
+	return s
+}
+
+// sqlBitmapTag starts a [SQLBitmap]'s encoding; it can't start a set's
+// text representation.
+const sqlBitmapTag byte = 0
+
+// SQLBitmap wraps a set to be stored in and scanned from BLOB/bytea
+// columns as a zero tag byte followed by the binary bitmap, i.e. byte
+// 1+i/8 has bit i%8 set iff i is an element of the set.  The tag lets
+// [Set.Scan] tell a bitmap from text.
+//
+//	err := row.Scan(ints.SQLBitmap{Set: s})
+type SQLBitmap struct{ Set *Set }
+
+// Value implements the [driver.Valuer] interface returning the
+// wrapped set's bitmap.  A nil set is stored as NULL.
+func (b SQLBitmap) Value() (driver.Value, error) {
+	if b.Set == nil {
+		return nil, nil
+	}
+	bb := []byte{sqlBitmapTag}
+	for _, w := range b.Set.Words() {
+		for i := 0; i < 8; i++ {
+			bb = append(bb, byte(w>>(8*i)))
+		}
+	}
+	for len(bb) > 1 && bb[len(bb)-1] == 0 {
+		bb = bb[:len(bb)-1]
+	}
+	return bb, nil
+}
+
+// Scan implements the [sql.Scanner] interface replacing the wrapped
+// set's elements with the elements of the scanned bitmap.  NULL is
+// scanned as empty set.  Scan fails if the bitmap's tag is missing.
+func (b SQLBitmap) Scan(src interface{}) error {
+	switch src := src.(type) {
+	case nil:
+		*b.Set = Set{}
+	case []byte:
+		if len(src) == 0 || src[0] != sqlBitmapTag {
+			return fmt.Errorf("ints: set: scan bitmap: missing tag")
+		}
+		*b.Set = *bitmapSet(src[1:])
+	default:
+		return fmt.Errorf("ints: set: scan bitmap: unsupported type %T",
+			src)
+	}
+	return nil
+}
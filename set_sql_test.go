@@ -0,0 +1,193 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package ints
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"io"
+	"sync"
+	"testing"
+
+	. "github.com/slukits/gounit"
+)
+
+// fakeDriver is an in-memory sql driver whose data source names
+// identify single column tables: an "INSERT" statement appends its
+// argument, a "SELECT" statement returns all appended values.
+type fakeDriver struct {
+	mutex  sync.Mutex
+	tables map[string]*table
+}
+
+type table struct {
+	mutex sync.Mutex
+	rows  []driver.Value
+}
+
+func (d *fakeDriver) Open(name string) (driver.Conn, error) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	if d.tables[name] == nil {
+		d.tables[name] = &table{}
+	}
+	return &fakeConn{d: d.tables[name]}, nil
+}
+
+type fakeConn struct{ d *table }
+
+func (c *fakeConn) Prepare(query string) (driver.Stmt, error) {
+	return &fakeStmt{d: c.d, query: query}, nil
+}
+
+func (c *fakeConn) Close() error              { return nil }
+func (c *fakeConn) Begin() (driver.Tx, error) { return nil, driver.ErrSkip }
+
+type fakeStmt struct {
+	d     *table
+	query string
+}
+
+func (s *fakeStmt) Close() error  { return nil }
+func (s *fakeStmt) NumInput() int { return -1 }
+
+func (s *fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	s.d.mutex.Lock()
+	defer s.d.mutex.Unlock()
+	s.d.rows = append(s.d.rows, args[0])
+	return driver.RowsAffected(1), nil
+}
+
+func (s *fakeStmt) Query([]driver.Value) (driver.Rows, error) {
+	s.d.mutex.Lock()
+	defer s.d.mutex.Unlock()
+	return &fakeRows{vv: append([]driver.Value{}, s.d.rows...)}, nil
+}
+
+type fakeRows struct{ vv []driver.Value }
+
+func (r *fakeRows) Columns() []string { return []string{"set"} }
+func (r *fakeRows) Close() error      { return nil }
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if len(r.vv) == 0 {
+		return io.EOF
+	}
+	dest[0], r.vv = r.vv[0], r.vv[1:]
+	return nil
+}
+
+var registerFakeDriver sync.Once
+
+// fakeDB returns a database with a new table.
+func fakeDB(t *T) *sql.DB {
+	registerFakeDriver.Do(func() {
+		sql.Register("ints-fake", &fakeDriver{tables: map[string]*table{}})
+	})
+	db, err := sql.Open("ints-fake", t.GoT().Name())
+	t.FatalOn(err)
+	return db
+}
+
+type setSQL struct{ Suite }
+
+func (s *setSQL) Stores_set_as_array_literal(t *T) {
+	v, err := FromSlice([]int{1, 2, 3}).Value()
+	t.FatalOn(err)
+	t.Eq("{1, 2, 3}", v)
+	v, err = (*Set)(nil).Value()
+	t.FatalOn(err)
+	t.True(v == nil)
+}
+
+func (s *setSQL) Scans_array_literals_and_range_syntax(t *T) {
+	for _, tt := range []struct {
+		src interface{}
+		exp string
+	}{
+		{"{1,2,3}", "{1, 2, 3}"},
+		{[]byte("{1, 2, 3}"), "{1, 2, 3}"},
+		{"{}", "{}"},
+		{"0-3,8", "{0, 1, 2, 3, 8}"},
+		{nil, "{}"},
+	} {
+		st := FromSlice([]int{42})
+		t.FatalOn(st.Scan(tt.src))
+		t.Eq(tt.exp, st.String())
+	}
+	t.Err((&Set{}).Scan("{1,NULL}"))
+	t.Err((&Set{}).Scan(42))
+}
+
+func (s *setSQL) Stores_bitmap_in_tagged_little_endian_bytes(t *T) {
+	v, err := SQLBitmap{FromSlice([]int{0, 9, 17})}.Value()
+	t.FatalOn(err)
+	t.Eq([]byte{0, 1, 2, 2}, v)
+	v, err = SQLBitmap{&Set{}}.Value()
+	t.FatalOn(err)
+	t.Eq([]byte{0}, v)
+	st := &Set{}
+	t.FatalOn(SQLBitmap{st}.Scan([]byte{0, 1, 2, 2}))
+	t.Eq("{0, 9, 17}", st.String())
+	t.Err(SQLBitmap{st}.Scan([]byte{1, 2, 2}))
+	t.Err(SQLBitmap{st}.Scan("text"))
+}
+
+func (s *setSQL) Scans_tagged_bitmap_bytes_into_set(t *T) {
+	st := &Set{}
+	t.FatalOn(st.Scan([]byte{0, 0x20}))
+	t.Eq("{5}", st.String())
+	t.FatalOn(st.Scan([]byte{0, 0x31, 0x32}))
+	t.Eq("{0, 4, 5, 9, 12, 13}", st.String())
+	t.FatalOn(st.Scan([]byte{0x31, 0x32}))
+	t.Eq("{12}", st.String())
+	bm, err := SQLBitmap{FromSlice([]int{0, 1, 2, 3, 64, 4711})}.Value()
+	t.FatalOn(err)
+	t.FatalOn(st.Scan(bm))
+	t.Eq("{0, 1, 2, 3, 64, 4711}", st.String())
+}
+
+func (s *setSQL) Scans_text_without_element_limit(t *T) {
+	exp, st := FromSlice([]int{20_000_000}), &Set{}
+	v, err := exp.Value()
+	t.FatalOn(err)
+	t.FatalOn(st.Scan(v))
+	t.True(exp.Eq(st))
+	t.FatalOn(st.Scan([]byte("{20000000}")))
+	t.True(exp.Eq(st))
+}
+
+func (s *setSQL) Round_trips_through_database(t *T) {
+	db := fakeDB(t)
+	defer db.Close()
+	exp := FromSlice([]int{0, 1, 2, 3, 64, 4711})
+	_, err := db.Exec("INSERT", exp)
+	t.FatalOn(err)
+	_, err = db.Exec("INSERT", SQLBitmap{exp})
+	t.FatalOn(err)
+
+	rows, err := db.Query("SELECT")
+	t.FatalOn(err)
+	defer rows.Close()
+	text, bitmap, blob := &Set{}, &Set{}, &Set{}
+	t.True(rows.Next())
+	t.FatalOn(rows.Scan(text))
+	t.True(rows.Next())
+	t.FatalOn(rows.Scan(SQLBitmap{bitmap}))
+	t.True(exp.Eq(text))
+	t.True(exp.Eq(bitmap))
+
+	rows, err = db.Query("SELECT")
+	t.FatalOn(err)
+	defer rows.Close()
+	t.True(rows.Next())
+	t.True(rows.Next())
+	t.FatalOn(rows.Scan(blob))
+	t.True(exp.Eq(blob))
+}
+
+func TestSetSQL(t *testing.T) {
+	Run(&setSQL{}, t)
+}
@@ -0,0 +1,217 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package ints
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+)
+
+// ErrInvalidSetStream is returned by a [SetDecoder] if the read stream
+// is not a valid set stream; ErrSetStreamChecksum if a record's
+// checksum doesn't match and ErrSetStreamNoBase if a delta record's
+// previous record was skipped.
+var (
+	ErrInvalidSetStream  = errors.New("ints: set stream: invalid stream")
+	ErrSetStreamChecksum = errors.New("ints: set stream: checksum mismatch")
+	ErrSetStreamNoBase   = errors.New("ints: set stream: delta without base")
+)
+
+// setStreamMagic starts a set stream followed by its version byte.
+var setStreamMagic = []byte("ints.sst")
+
+const setStreamVersion = 1
+
+// maxSetRecord bounds a record's payload length to keep a corrupt
+// stream from allocating an arbitrary amount of memory.
+const maxSetRecord = 1 << 30
+
+const (
+	setRecordFull byte = iota
+	setRecordDelta
+)
+
+// SetEncoder writes a stream of sets to an io.Writer.  Each set is
+// written as a record
+//
+//	kind     byte   (full set or delta against the previous set)
+//	length   uvarint
+//	payload  [length]byte
+//	checksum uint32 (little-endian crc32 of kind and payload)
+//
+// whereas the stream starts with a magic number and a version byte.
+// The length prefix allows a [SetDecoder] to skip records without
+// decoding them.
+type SetEncoder struct {
+	w       io.Writer
+	started bool
+	every   int
+	count   int
+	prev    *Set
+}
+
+// NewSetEncoder returns an encoder writing to given writer.  By default
+// every set is written in full, see [SetEncoder.Delta].
+func NewSetEncoder(w io.Writer) *SetEncoder {
+	return &SetEncoder{w: w}
+}
+
+// Delta enables delta encoding, i.e. a set is written as the patch
+// against the previously written set if that is smaller.  Every n-th
+// record is written in full anyway so a decoder may resume decoding
+// after skipped records.  n smaller than one disables full records.
+func (e *SetEncoder) Delta(n int) *SetEncoder {
+	e.every, e.prev = n, &Set{}
+	if n < 1 {
+		e.every = -1
+	}
+	return e
+}
+
+// Encode writes given set as next record.
+func (e *SetEncoder) Encode(s *Set) error {
+	if !e.started {
+		hdr := append(append([]byte{}, setStreamMagic...), setStreamVersion)
+		if _, err := e.w.Write(hdr); err != nil {
+			return err
+		}
+		e.started = true
+	}
+	kind, payload := setRecordFull, s.appendPatchEncoding(nil)
+	if e.every != 0 {
+		if e.every < 0 || e.count%e.every != 0 {
+			delta, _ := Diff(e.prev, s).MarshalBinary()
+			if len(delta) < len(payload) {
+				kind, payload = setRecordDelta, delta
+			}
+		}
+		e.prev = s.copy()
+	}
+	e.count++
+	rec := appendUvarint([]byte{kind}, uint64(len(payload)))
+	rec = append(rec, payload...)
+	sum := crc32.Update(crc32.ChecksumIEEE([]byte{kind}),
+		crc32.IEEETable, payload)
+	rec = append(rec, 0, 0, 0, 0)
+	binary.LittleEndian.PutUint32(rec[len(rec)-4:], sum)
+	_, err := e.w.Write(rec)
+	return err
+}
+
+// SetDecoder reads a stream of sets written by a [SetEncoder].  Note
+// that a decoder may read more bytes from its reader than it has
+// decoded.
+type SetDecoder struct {
+	r       *bufio.Reader
+	started bool
+	prev    *Set // nil if the previous record was skipped
+	max     int
+}
+
+// NewSetDecoder returns a decoder reading from given reader.
+func NewSetDecoder(r io.Reader) *SetDecoder {
+	return &SetDecoder{r: bufio.NewReader(r), prev: &Set{}}
+}
+
+// MaxElement sets the greatest element of a decoded set; n smaller
+// than one restores the default [DefaultMaxSetElement].
+func (d *SetDecoder) MaxElement(n int) *SetDecoder {
+	d.max = n
+	return d
+}
+
+// Decode returns the next set of the stream.  It returns io.EOF if
+// there are no more records.  Decoding a delta record whose previous
+// record was skipped fails with [ErrSetStreamNoBase] and decoding a
+// record with an element greater than the decoder's maximal element
+// fails with an [ErrInvalidSetStream] (see [SetDecoder.MaxElement]).
+func (d *SetDecoder) Decode() (*Set, error) {
+	kind, payload, err := d.next(false)
+	if err != nil {
+		return nil, err
+	}
+	s := &Set{}
+	switch kind {
+	case setRecordFull:
+		rest, err := s.decodePatchEncoding(payload,
+			maxSetElement(d.max))
+		if err != nil || len(rest) > 0 {
+			return nil, fmt.Errorf("%w: set payload", ErrInvalidSetStream)
+		}
+	case setRecordDelta:
+		if d.prev == nil {
+			return nil, ErrSetStreamNoBase
+		}
+		p := (&SetPatch{}).MaxElement(d.max)
+		if err := p.UnmarshalBinary(payload); err != nil {
+			return nil, fmt.Errorf("%w: delta payload", ErrInvalidSetStream)
+		}
+		s = d.prev.copy().Apply(p)
+	}
+	d.prev = s.copy()
+	return s, nil
+}
+
+// Skip skips the next record without decoding it.  It returns io.EOF
+// if there are no more records.
+func (d *SetDecoder) Skip() error {
+	_, _, err := d.next(true)
+	d.prev = nil
+	return err
+}
+
+// next reads the next record and verifies its checksum; a skipped
+// record's payload is discarded unread.
+func (d *SetDecoder) next(skip bool) (byte, []byte, error) {
+	if !d.started {
+		hdr := make([]byte, len(setStreamMagic)+1)
+		if _, err := io.ReadFull(d.r, hdr); err != nil {
+			if err == io.EOF {
+				return 0, nil, io.EOF
+			}
+			return 0, nil, fmt.Errorf("%w: header", ErrInvalidSetStream)
+		}
+		if string(hdr[:len(setStreamMagic)]) != string(setStreamMagic) ||
+			hdr[len(setStreamMagic)] != setStreamVersion {
+			return 0, nil, fmt.Errorf("%w: header", ErrInvalidSetStream)
+		}
+		d.started = true
+	}
+	kind, err := d.r.ReadByte()
+	if err != nil {
+		return 0, nil, err // io.EOF at a record boundary
+	}
+	if kind != setRecordFull && kind != setRecordDelta {
+		return 0, nil, fmt.Errorf("%w: record kind", ErrInvalidSetStream)
+	}
+	n, err := binary.ReadUvarint(d.r)
+	if err != nil || n > maxSetRecord {
+		return 0, nil, fmt.Errorf("%w: record length", ErrInvalidSetStream)
+	}
+	if skip {
+		if _, err := d.r.Discard(int(n) + 4); err != nil {
+			return 0, nil, fmt.Errorf("%w: truncated", ErrInvalidSetStream)
+		}
+		return kind, nil, nil
+	}
+	// grow the payload as data arrives rather than trusting the length
+	// header with an upfront allocation.
+	buf := &bytes.Buffer{}
+	if _, err := io.CopyN(buf, d.r, int64(n)+4); err != nil {
+		return 0, nil, fmt.Errorf("%w: truncated", ErrInvalidSetStream)
+	}
+	payload := buf.Bytes()
+	payload, sum := payload[:n], binary.LittleEndian.Uint32(payload[n:])
+	if crc32.Update(crc32.ChecksumIEEE([]byte{kind}),
+		crc32.IEEETable, payload) != sum {
+		return 0, nil, ErrSetStreamChecksum
+	}
+	return kind, payload, nil
+}
@@ -0,0 +1,161 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package ints
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"io"
+	"runtime"
+	"testing"
+
+	. "github.com/slukits/gounit"
+)
+
+type setStream struct{ Suite }
+
+func (s *setStream) SetUp(t *T) { t.Parallel() }
+
+// ticks returns a sequence of slowly changing sets.
+func ticks() (ss []*Set) {
+	st := &Set{}
+	for i := 0; i < 1500; i += 3 {
+		st.Add(i)
+	}
+	for i := 0; i < 20; i++ {
+		st.Add(i*71+1, i*37+2).Del(i * 63)
+		ss = append(ss, st.copy())
+	}
+	return ss
+}
+
+func encode(t *T, enc func(io.Writer) *SetEncoder, ss []*Set) []byte {
+	buf := &bytes.Buffer{}
+	e := enc(buf)
+	for _, s := range ss {
+		t.FatalOn(e.Encode(s))
+	}
+	return buf.Bytes()
+}
+
+func full(w io.Writer) *SetEncoder { return NewSetEncoder(w) }
+
+func delta(n int) func(w io.Writer) *SetEncoder {
+	return func(w io.Writer) *SetEncoder { return NewSetEncoder(w).Delta(n) }
+}
+
+func (s *setStream) Decodes_encoded_sets(t *T) {
+	for _, enc := range []func(io.Writer) *SetEncoder{
+		full, delta(0), delta(5)} {
+		ss := ticks()
+		d := NewSetDecoder(bytes.NewReader(encode(t, enc, ss)))
+		for _, exp := range ss {
+			got, err := d.Decode()
+			t.FatalOn(err)
+			t.True(exp.Eq(got))
+		}
+		_, err := d.Decode()
+		t.ErrIs(err, io.EOF)
+	}
+}
+
+func (s *setStream) Delta_encoding_is_smaller(t *T) {
+	t.True(len(encode(t, delta(0), ticks())) <
+		len(encode(t, full, ticks())))
+}
+
+func (s *setStream) Empty_stream_is_at_eof(t *T) {
+	_, err := NewSetDecoder(&bytes.Buffer{}).Decode()
+	t.ErrIs(err, io.EOF)
+}
+
+func (s *setStream) Skips_records_without_decoding(t *T) {
+	ss := ticks()
+	d := NewSetDecoder(bytes.NewReader(encode(t, full, ss)))
+	t.FatalOn(d.Skip())
+	t.FatalOn(d.Skip())
+	got, err := d.Decode()
+	t.FatalOn(err)
+	t.True(ss[2].Eq(got))
+}
+
+func (s *setStream) Resumes_delta_decoding_at_full_record(t *T) {
+	ss := ticks()
+	d := NewSetDecoder(bytes.NewReader(encode(t, delta(5), ss)))
+	t.FatalOn(d.Skip())
+	_, err := d.Decode()
+	t.ErrIs(err, ErrSetStreamNoBase)
+	for i := 2; i < 5; i++ {
+		t.FatalOn(d.Skip())
+	}
+	got, err := d.Decode()
+	t.FatalOn(err)
+	t.True(ss[5].Eq(got))
+	got, err = d.Decode()
+	t.FatalOn(err)
+	t.True(ss[6].Eq(got))
+}
+
+func (s *setStream) Detects_corruption(t *T) {
+	bb := encode(t, full, []*Set{FromSlice([]int{1, 2, 3})})
+	corrupt := append([]byte{}, bb...)
+	corrupt[len(corrupt)-6] ^= 0xff
+	_, err := NewSetDecoder(bytes.NewReader(corrupt)).Decode()
+	t.ErrIs(err, ErrSetStreamChecksum)
+	_, err = NewSetDecoder(bytes.NewReader(bb[:len(bb)-1])).Decode()
+	t.ErrIs(err, ErrInvalidSetStream)
+	_, err = NewSetDecoder(bytes.NewReader([]byte("no stream"))).Decode()
+	t.ErrIs(err, ErrInvalidSetStream)
+}
+
+// streamOf returns the stream of a single record of given kind and
+// payload.
+func streamOf(kind byte, payload []byte) []byte {
+	bb := append(append([]byte{}, setStreamMagic...), setStreamVersion)
+	bb = appendUvarint(append(bb, kind), uint64(len(payload)))
+	bb = append(bb, payload...)
+	sum := crc32.Update(crc32.ChecksumIEEE([]byte{kind}),
+		crc32.IEEETable, payload)
+	bb = append(bb, 0, 0, 0, 0)
+	binary.LittleEndian.PutUint32(bb[len(bb)-4:], sum)
+	return bb
+}
+
+func (s *setStream) Rejects_hostile_records(t *T) {
+	runs := appendUvarint([]byte{patchRuns, 1, 0}, 1<<28)
+	_, err := NewSetDecoder(bytes.NewReader(
+		streamOf(setRecordFull, runs))).Decode()
+	t.ErrIs(err, ErrInvalidSetStream)
+	_, err = NewSetDecoder(bytes.NewReader(
+		streamOf(setRecordDelta, append(runs, patchRuns, 0)))).Decode()
+	t.ErrIs(err, ErrInvalidSetStream)
+
+	runs = appendUvarint([]byte{patchRuns, 1, 0}, 1<<10)
+	d := NewSetDecoder(bytes.NewReader(streamOf(setRecordFull, runs)))
+	_, err = d.MaxElement(1 << 9).Decode()
+	t.ErrIs(err, ErrInvalidSetStream)
+	got, err := NewSetDecoder(bytes.NewReader(
+		streamOf(setRecordFull, runs))).Decode()
+	t.FatalOn(err)
+	t.Eq(1<<10+1, got.Len())
+}
+
+func (s *setStream) Reads_records_without_trusting_their_length(t *T) {
+	bb := append(append([]byte{}, setStreamMagic...), setStreamVersion)
+	bb = append(appendUvarint(append(bb, setRecordFull), maxSetRecord),
+		1, 2, 3)
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	_, err := NewSetDecoder(bytes.NewReader(bb)).Decode()
+	runtime.ReadMemStats(&after)
+	t.ErrIs(err, ErrInvalidSetStream)
+	t.True(after.TotalAlloc-before.TotalAlloc < maxSetRecord/2)
+}
+
+func TestSetStream(t *testing.T) {
+	t.Parallel()
+	Run(&setStream{}, t)
+}
@@ -136,6 +136,34 @@ func (s *set) Provides_its_elements_as_string(t *T) {
 	t.Eq(exp, st.String())
 }
 
+func (s *set) Copy_is_independent_of_original(t *T) {
+	st := FromSlice([]int{1, 2})
+	cp := st.copy().Add(3)
+	t.Eq("{1, 2}", st.String())
+	t.Eq("{1, 2, 3}", cp.String())
+}
+
+func (s *set) Union_has_elements_of_both_sets(t *T) {
+	a, b := FromSlice([]int{1, 2, 200}), FromSlice([]int{2, 3})
+	t.Eq("{1, 2, 3, 200}", a.union(b).String())
+	t.Eq("{1, 2, 3, 200}", b.union(a).String())
+	t.Eq(4, a.union(b).Len())
+}
+
+func (s *set) Intersection_has_common_elements(t *T) {
+	a, b := FromSlice([]int{1, 2, 200}), FromSlice([]int{2, 3, 200})
+	t.Eq("{2, 200}", a.intersect(b).String())
+	t.Eq(2, a.intersect(b).Len())
+	t.True(a.intersect(FromSlice([]int{1})).Eq(FromSlice([]int{1})))
+}
+
+func (s *set) Difference_has_elements_not_in_other_set(t *T) {
+	a, b := FromSlice([]int{1, 2, 200}), FromSlice([]int{2, 3})
+	t.Eq("{1, 200}", a.diff(b).String())
+	t.Eq("{3}", b.diff(a).String())
+	t.Eq(2, a.diff(b).Len())
+}
+
 func TestSet(t *testing.T) {
 	Run(&set{}, t)
 }
@@ -0,0 +1,202 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package ints
+
+import (
+	"math"
+	"strings"
+)
+
+// MaxFractionals128 is the maximal number of fractionals of a
+// [U128Context].
+const MaxFractionals128 = 19
+
+// A U128Context represents an environment to do arithmetic with
+// 128 bit wide decimals which have up to [MaxFractionals128]
+// fractionals.  A U128Context's zero value is NOT ready to use.  Create
+// a new context by calling [ints.UDec128]'s [U128Context.New] method.
+type U128Context struct {
+	separator, fmtSeparator     rune
+	fractionals, fmtFractionals int
+	pow                         uint64
+
+	// From provides conversion methods returning UDecimal128-values.
+	From *U128Convert
+
+	// Max is the maximal representable UDecimal128 value having a
+	// context's fractionals.
+	Max UDecimal128
+}
+
+// UDec128 is the default 128 bit context having eighteen fractionals
+// and a dot decimal separator for conversions while the format flags
+// default to [COMMA_SEPARATOR] | [TWO_FRACTIONALS].
+var UDec128 = newU128Context(18, '.', DEFAULTS)
+
+// New creates a new U128Context-instance with given number of
+// fractionals.  The separator of given arithmetic flags and the format
+// flags are handled like the flags of [UContext.New] while the
+// arithmetic flags' fractionals are ignored.  New fails with
+// [ErrFractionals] if fractionals is negative or greater than
+// [MaxFractionals128].
+func (c *U128Context) New(
+	fractionals int, art, fmt Flags,
+) (*U128Context, error) {
+	if c == nil || c.From == nil {
+		return UDec128.New(fractionals, art, fmt)
+	}
+	if fractionals < 0 || fractionals > MaxFractionals128 {
+		return nil, ErrFractionals
+	}
+	sep, a := c.separator, Flags(0)
+	if a.set(art); a&ffSeparators != 0 {
+		sep = flagsToSeparator[a&ffSeparators]
+	}
+	cntx := newU128Context(fractionals, sep, DEFAULTS)
+	cntx.fmtSeparator, cntx.fmtFractionals = c.fmtSeparator,
+		c.fmtFractionals
+	cntx.SetFmt(fmt)
+	return cntx, nil
+}
+
+// MNew is the "Must"-variant of [U128Context.New] which panics if
+// corresponding New-call fails.
+func (c *U128Context) MNew(fractionals int, art, fmt Flags) *U128Context {
+	cntx, err := c.New(fractionals, art, fmt)
+	if err != nil {
+		panic(err)
+	}
+	return cntx
+}
+
+func newU128Context(fractionals int, sep rune, fmt Flags) *U128Context {
+	c := &U128Context{separator: sep, fractionals: fractionals,
+		pow: pow10(fractionals), fmtSeparator: ',', fmtFractionals: 2}
+	max := UDecimal128{Hi: math.MaxUint64, Lo: math.MaxUint64}
+	_, r := divWords64(max.words(), c.pow)
+	c.Max = sub128(max, UDecimal128{Lo: r + 1})
+	c.From = &U128Convert{cntx: c}
+	c.SetFmt(fmt)
+	return c
+}
+
+// Fractionals returns the number of fractionals of given context.
+func (c *U128Context) Fractionals() int { return c.fractionals }
+
+// SetFmt sets given context's format flags.  Is more than one
+// fractional or separator flag given only one of them is used and it
+// is undefined which one.
+func (c *U128Context) SetFmt(ff Flags) {
+	var f Flags
+	f.set(ff)
+	if f&ffSeparators != 0 {
+		c.fmtSeparator = flagsToSeparator[f&ffSeparators]
+	}
+	if f&ffFractionals != 0 {
+		c.fmtFractionals = flagsToFractionals[f&ffFractionals]
+	}
+}
+
+// format returns given decimal's digits with given number of
+// fractionals separated by the context's format separator and padded
+// with given number of zeros.
+func (c *U128Context) format(d UDecimal128, fractionals, pad int) string {
+	s := d.dec()
+	if fractionals+pad == 0 {
+		return s
+	}
+	if len(s) <= fractionals {
+		s = strings.Repeat("0", fractionals-len(s)+1) + s
+	}
+	return s[:len(s)-fractionals] + string(c.fmtSeparator) +
+		s[len(s)-fractionals:] + strings.Repeat("0", pad)
+}
+
+// Add adds given decimals and returns their sum.  Add fails if the
+// result overflows given context's Max property.
+func (c *U128Context) Add(a, b UDecimal128) (UDecimal128, error) {
+	sum, overflow := add128(a, b)
+	if overflow || sum.Cmp(c.Max) > 0 {
+		return UDecimal128{}, ErrOverflow
+	}
+	return sum, nil
+}
+
+// MAdd is the 'Must'-version of [U128Context.Add] which panics if
+// corresponding Add-call fails.
+func (c *U128Context) MAdd(a, b UDecimal128) UDecimal128 {
+	sum, err := c.Add(a, b)
+	if err != nil {
+		panic(err)
+	}
+	return sum
+}
+
+// Sub subtracts given decimal b from a and returns their difference.
+// Sub fails with an overflow error if b is greater than a.
+func (c *U128Context) Sub(a, b UDecimal128) (UDecimal128, error) {
+	if b.Cmp(a) > 0 {
+		return UDecimal128{}, ErrOverflow
+	}
+	return sub128(a, b), nil
+}
+
+// MSub is the 'Must'-version of [U128Context.Sub] which panics if
+// corresponding Sub-call fails.
+func (c *U128Context) MSub(a, b UDecimal128) UDecimal128 {
+	diff, err := c.Sub(a, b)
+	if err != nil {
+		panic(err)
+	}
+	return diff
+}
+
+// Mult multiplies given decimals and returns their truncated product.
+// Mult fails if the product is greater than Max of given context.
+func (c *U128Context) Mult(a, b UDecimal128) (UDecimal128, error) {
+	q, _ := divWords64(mulWords(a.words(), b.words()), c.pow)
+	return c.fit(q)
+}
+
+// MMult is the 'Must'-variant of [U128Context.Mult] which panics if
+// corresponding Mult-call fails.
+func (c *U128Context) MMult(a, b UDecimal128) UDecimal128 {
+	prd, err := c.Mult(a, b)
+	if err != nil {
+		panic(err)
+	}
+	return prd
+}
+
+// Div divides a by b and returns resulting truncated quotient.  Div
+// fails if the quotient is greater than Max of given context or if b
+// is zero.
+func (c *U128Context) Div(a, b UDecimal128) (UDecimal128, error) {
+	if b.IsZero() {
+		return UDecimal128{}, ErrDividedByZero
+	}
+	q, _ := divWords(mulWords(a.words(), []uint64{c.pow}), b)
+	return c.fit(q)
+}
+
+// MDiv is the 'Must'-variant of [U128Context.Div] which panics if
+// corresponding Div-call fails.
+func (c *U128Context) MDiv(a, b UDecimal128) UDecimal128 {
+	qut, err := c.Div(a, b)
+	if err != nil {
+		panic(err)
+	}
+	return qut
+}
+
+// fit returns given words as decimal or an overflow error if they
+// exceed the context's Max property.
+func (c *U128Context) fit(w []uint64) (UDecimal128, error) {
+	d, ok := fits128(w)
+	if !ok || d.Cmp(c.Max) > 0 {
+		return UDecimal128{}, ErrOverflow
+	}
+	return d, nil
+}
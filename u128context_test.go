@@ -0,0 +1,111 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package ints
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+
+	. "github.com/slukits/gounit"
+)
+
+type u128Context struct{ Suite }
+
+func (s *u128Context) SetUp(t *T) { t.Parallel() }
+
+func (s *u128Context) Has_eighteen_fractionals_by_default(t *T) {
+	t.Eq(18, UDec128.Fractionals())
+	t.Eq("340282366920938463462999999999999999999", UDec128.Max.dec())
+	t.Eq("340282366920938463462,99", UDec128.Max.Str(UDec128))
+}
+
+func (s *u128Context) Fails_for_unrepresentable_fractionals(t *T) {
+	_, err := UDec128.New(MaxFractionals128+1, DEFAULTS, DEFAULTS)
+	t.ErrIs(err, ErrFractionals)
+	_, err = (*U128Context)(nil).New(-1, DEFAULTS, DEFAULTS)
+	t.ErrIs(err, ErrFractionals)
+	t.Panics(func() { UDec128.MNew(20, DEFAULTS, DEFAULTS) })
+	dec := UDec128.MNew(MaxFractionals128, COMMA_SEPARATOR, DOT_SEPARATOR)
+	t.Eq("34028236692093846345.32", dec.From.MStr(
+		"34028236692093846345,3211").Str(dec))
+	t.Eq("34028236692093846345,9999999999999999999", dec.Max.dec()[:20]+
+		","+dec.Max.dec()[20:])
+}
+
+func (s *u128Context) Adds_and_subtracts(t *T) {
+	a := UDec128.From.MStr("18446744073709551615.5")
+	b := UDec128.From.MStr("0.500000000000000001")
+	t.Eq("18446744073709551616.000000000000000001",
+		UDec128.MAdd(a, b).dec()[:20]+"."+UDec128.MAdd(a, b).dec()[20:])
+	t.Eq(a, UDec128.MSub(UDec128.MAdd(a, b), b))
+	_, err := UDec128.Add(UDec128.Max, UDecimal128{Lo: 1})
+	t.ErrIs(err, ErrOverflow)
+	_, err = UDec128.Sub(b, a)
+	t.ErrIs(err, ErrOverflow)
+	t.Panics(func() { UDec128.MAdd(UDec128.Max, UDec128.Max) })
+	t.Panics(func() { UDec128.MSub(b, a) })
+}
+
+func (s *u128Context) Multiplies_beyond_64_bit(t *T) {
+	a := UDec128.From.MStr("12345678901.123")
+	t.Eq("152415787529633604810,66",
+		UDec128.MMult(a, a).Str(UDec128))
+	t.Eq(UDecimal128{}, UDec128.MMult(a, UDecimal128{}))
+	_, err := UDec128.Mult(UDec128.Max, UDec128.From.MStr("1.5"))
+	t.ErrIs(err, ErrOverflow)
+	t.Panics(func() { UDec128.MMult(a, UDec128.Max) })
+}
+
+func (s *u128Context) Divides_by_wide_and_narrow_divisors(t *T) {
+	a := UDec128.From.MStr("100000000000000000000")
+	dec := UDec128.MNew(18, DEFAULTS, DOT_SEPARATOR|EIGHT_FRACTIONALS)
+	t.Eq("3.33333333", UDec128.MDiv(a, UDec128.From.MStr(
+		"30000000000000000000")).Str(dec))
+	t.Eq("0.14285714", UDec128.MDiv(UDec128.From.MStr("1"),
+		UDec128.From.MStr("7")).Str(dec))
+	_, err := UDec128.Div(a, UDecimal128{})
+	t.ErrIs(err, ErrDividedByZero)
+	_, err = UDec128.Div(UDec128.Max, UDec128.From.MStr("0.5"))
+	t.ErrIs(err, ErrOverflow)
+	t.Panics(func() { UDec128.MDiv(a, UDecimal128{}) })
+}
+
+func (s *u128Context) Agrees_with_big_int_arithmetic(t *T) {
+	rng := rand.New(rand.NewSource(1))
+	pow := new(big.Int).SetUint64(UDec128.pow)
+	toBig := func(d UDecimal128) *big.Int {
+		b := new(big.Int).SetUint64(d.Hi)
+		return b.Lsh(b, 64).Add(b, new(big.Int).SetUint64(d.Lo))
+	}
+	max := toBig(UDec128.Max)
+	for i := 0; i < 1000; i++ {
+		a := UDecimal128{Hi: rng.Uint64() >> rng.Intn(64),
+			Lo: rng.Uint64()}
+		b := UDecimal128{Hi: rng.Uint64() >> (32 + rng.Intn(33)),
+			Lo: rng.Uint64()}
+		want := new(big.Int).Mul(toBig(a), toBig(b))
+		want.Quo(want, pow)
+		got, err := UDec128.Mult(a, b)
+		if want.Cmp(max) > 0 {
+			t.ErrIs(err, ErrOverflow)
+		} else {
+			t.Eq(want.String(), toBig(got).String())
+		}
+		want = new(big.Int).Mul(toBig(a), pow)
+		want.Quo(want, toBig(b))
+		got, err = UDec128.Div(a, b)
+		if want.Cmp(max) > 0 {
+			t.ErrIs(err, ErrOverflow)
+		} else {
+			t.Eq(want.String(), toBig(got).String())
+		}
+	}
+}
+
+func TestU128Context(t *testing.T) {
+	t.Parallel()
+	Run(&u128Context{}, t)
+}
@@ -0,0 +1,174 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package ints
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// U128Convert provides the [UDecimal128]-value creating conversion
+// functions of a [U128Context]'s From-property.
+type U128Convert struct {
+	cntx *U128Context
+}
+
+// Str converts given string to a [UDecimal128]-value.  The string is
+// interpreted as [UConvert.Str] does, i.e. superfluous fractionals are
+// truncated.  Str fails if the resulting decimal is greater than the
+// associated context's Max-property or if the string has other
+// characters than digits and one decimal separator.
+func (c U128Convert) Str(s string) (UDecimal128, error) {
+	ii, ff := s, ""
+	if i := strings.IndexRune(s, c.cntx.separator); i >= 0 {
+		ii, ff = s[:i], s[i+len(string(c.cntx.separator)):]
+	}
+	if len(ff) > c.cntx.fractionals {
+		ff = ff[:c.cntx.fractionals]
+	}
+	digits := ii + ff + strings.Repeat("0", c.cntx.fractionals-len(ff))
+	d := []uint64{0, 0}
+	for _, r := range digits {
+		if r < '0' || r > '9' {
+			return UDecimal128{}, fmt.Errorf(
+				"ints: dec128: parse %q: %w", s, strconv.ErrSyntax)
+		}
+		d = mulWords(d, []uint64{10})
+		if d[2] != 0 {
+			return UDecimal128{}, ErrOverflow
+		}
+		sum, overflow := add128(UDecimal128{Hi: d[1], Lo: d[0]},
+			UDecimal128{Lo: uint64(r - '0')})
+		if overflow {
+			return UDecimal128{}, ErrOverflow
+		}
+		d = sum.words()
+	}
+	return c.cntx.fit(d)
+}
+
+// MStr is the "must"-variant of [U128Convert.Str] which panics if
+// corresponding Str-call fails.
+func (c U128Convert) MStr(s string) UDecimal128 {
+	v, err := c.Str(s)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints converts given integrals, fractionals and leading zeros lz of
+// the fractionals to a [UDecimal128]-value; see [UConvert.Ints].
+func (c U128Convert) Ints(
+	integrals, fractionals uint64, lz int,
+) (UDecimal128, error) {
+	if integrals == 0 && fractionals == 0 {
+		return UDecimal128{}, nil
+	}
+	if lz > 0 && lz >= c.cntx.fractionals {
+		return UDecimal128{}, ErrOverflow
+	}
+	n := len(utoa(fractionals)) + lz
+	if fractionals == 0 {
+		n = 0
+	}
+	if n > c.cntx.fractionals {
+		fractionals /= pow10(n - c.cntx.fractionals)
+	} else {
+		fractionals *= pow10(c.cntx.fractionals - n)
+	}
+	d := mulWords([]uint64{integrals}, []uint64{c.cntx.pow})
+	sum, overflow := add128(UDecimal128{Hi: d[1], Lo: d[0]},
+		UDecimal128{Lo: fractionals})
+	if overflow {
+		return UDecimal128{}, ErrOverflow
+	}
+	return c.cntx.fit(sum.words())
+}
+
+// MInts is the "Must"-variant of [U128Convert.Ints] which panics if
+// corresponding Ints-call fails.
+func (c U128Convert) MInts(
+	integrals, fractionals uint64, lz int,
+) UDecimal128 {
+	v, err := c.Ints(integrals, fractionals, lz)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Cntx converts given decimal of given context to a decimal of
+// receiving context.  Cntx fails if the conversion overflows.  If
+// receiving context has less fractionals than given context the
+// superfluous fractionals are rounded to even.
+func (c U128Convert) Cntx(
+	d UDecimal128, cx *U128Context,
+) (UDecimal128, error) {
+	v, ok := rescale128(d, cx.fractionals, c.cntx.fractionals)
+	if !ok {
+		return UDecimal128{}, ErrOverflow
+	}
+	return c.cntx.fit(v.words())
+}
+
+// MCntx is the "Must"-variant of [U128Convert.Cntx] which panics if
+// corresponding Cntx-call fails.
+func (c U128Convert) MCntx(d UDecimal128, cx *U128Context) UDecimal128 {
+	v, err := c.Cntx(d, cx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// UDecimal converts given decimal of given context to a decimal of
+// receiving context.  It fails if the conversion overflows.  If
+// receiving context has less fractionals than given context the
+// superfluous fractionals are rounded to even.
+func (c U128Convert) UDecimal(
+	d UDecimal, cx *UContext,
+) (UDecimal128, error) {
+	v, ok := rescale128(UDecimal128{Lo: uint64(d)},
+		cx.flags.Fractionals(), c.cntx.fractionals)
+	if !ok {
+		return UDecimal128{}, ErrOverflow
+	}
+	return c.cntx.fit(v.words())
+}
+
+// MUDecimal is the "Must"-variant of [U128Convert.UDecimal] which
+// panics if corresponding UDecimal-call fails.
+func (c U128Convert) MUDecimal(d UDecimal, cx *UContext) UDecimal128 {
+	v, err := c.UDecimal(d, cx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// U128 converts given decimal of given 128 bit context to a
+// [UDecimal]-value of receiving context.  It fails if the result is
+// greater than the associated [UContext]'s Max-property.  If receiving
+// context has less fractionals than given context the superfluous
+// fractionals are rounded to even.
+func (c UConvert) U128(d UDecimal128, cx *U128Context) (UDecimal, error) {
+	v, ok := rescale128(d, cx.fractionals, c.cntx.flags.Fractionals())
+	if !ok || v.Hi != 0 || UDecimal(v.Lo) > c.cntx.Max {
+		return 0, ErrOverflow
+	}
+	return UDecimal(v.Lo), nil
+}
+
+// MU128 is the "Must"-variant of [UConvert.U128] which panics if
+// corresponding U128-call fails.
+func (c UConvert) MU128(d UDecimal128, cx *U128Context) UDecimal {
+	v, err := c.U128(d, cx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
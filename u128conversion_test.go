@@ -0,0 +1,86 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package ints
+
+import (
+	"testing"
+
+	. "github.com/slukits/gounit"
+)
+
+type u128Conversion struct{ Suite }
+
+func (s *u128Conversion) SetUp(t *T) { t.Parallel() }
+
+func (s *u128Conversion) Parses_strings_truncating_fractionals(t *T) {
+	dec := UDec128.MNew(4, DEFAULTS, DEFAULTS)
+	t.Eq(UDecimal128{Lo: 12345}, dec.From.MStr("1.23456"))
+	t.Eq(UDecimal128{Lo: 42000}, dec.From.MStr("4.2"))
+	for _, z := range []string{"", ".", "0.", ".0", "0.0"} {
+		t.Eq(UDecimal128{}, dec.From.MStr(z))
+	}
+	for _, s := range []string{"abc", "4.a", "-4", "4.2.1"} {
+		_, err := dec.From.Str(s)
+		t.ErrMatched(err, "invalid syntax")
+	}
+	_, err := UDec128.From.Str("340282366920938463463")
+	t.ErrIs(err, ErrOverflow)
+	_, err = UDec128.From.Str("3402823669209384634630")
+	t.ErrIs(err, ErrOverflow)
+	t.Panics(func() { UDec128.From.MStr("x") })
+}
+
+func (s *u128Conversion) Converts_ints(t *T) {
+	dec := UDec128.MNew(4, DEFAULTS, DEFAULTS)
+	t.Eq(UDecimal128{Lo: 12345}, dec.From.MInts(1, 23456, 0))
+	t.Eq(UDecimal128{Lo: 40002}, dec.From.MInts(4, 2, 3))
+	t.Eq(UDecimal128{Lo: 40000}, dec.From.MInts(4, 0, 0))
+	t.Eq(UDecimal128{}, dec.From.MInts(0, 0, 0))
+	_, err := dec.From.Ints(4, 2, 4)
+	t.ErrIs(err, ErrOverflow)
+	t.Eq("18446744073709551615,00",
+		UDec128.From.MInts(1<<64-1, 0, 0).Str(UDec128))
+	t.Panics(func() { dec.From.MInts(1, 1, 4) })
+	zero := UDec128.MNew(0, DEFAULTS, DEFAULTS)
+	t.Eq(UDecimal128{Lo: 5}, zero.From.MInts(5, 0, 0))
+	_, err = zero.From.Ints(5, 1, 1)
+	t.ErrIs(err, ErrOverflow)
+}
+
+func (s *u128Conversion) Converts_between_contexts(t *T) {
+	dec := UDec128.MNew(2, DEFAULTS, DEFAULTS)
+	t.Eq(UDecimal128{Lo: 124}, dec.From.MCntx(
+		UDec128.From.MStr("1.235"), UDec128))
+	t.Eq(UDec128.From.MStr("1.24"), UDec128.From.MCntx(
+		UDecimal128{Lo: 124}, dec))
+	_, err := UDec128.From.Cntx(UDecimal128{Hi: 1 << 63}, dec)
+	t.ErrIs(err, ErrOverflow)
+	t.Panics(func() { UDec128.From.MCntx(dec.Max, dec) })
+}
+
+func (s *u128Conversion) Converts_to_and_from_udecimal(t *T) {
+	d := UDec.From.MStr("1234.567891")
+	d128 := UDec128.From.MUDecimal(d, UDec)
+	t.Eq(UDec128.From.MStr("1234.567891"), d128)
+	t.Eq(d, UDec.From.MU128(d128, UDec128))
+	dec := UDec.New(TWO_FRACTIONALS, DEFAULTS)
+	t.Eq(UDecimal(123457), dec.From.MU128(d128, UDec128))
+	_, err := UDec.From.U128(UDec128.Max, UDec128)
+	t.ErrIs(err, ErrOverflow)
+	t.Panics(func() {
+		UDec.From.MU128(UDec128.From.MStr(
+			"18446744073709"), UDec128)
+	})
+	dec128 := UDec128.MNew(0, DEFAULTS, DEFAULTS)
+	t.Eq(UDecimal128{Lo: 1235}, dec128.From.MUDecimal(
+		UDec.From.MStr("1234.51"), UDec))
+	_, err = dec128.From.UDecimal(UDec.Max, UDec)
+	t.FatalOn(err)
+}
+
+func TestU128Conversion(t *testing.T) {
+	t.Parallel()
+	Run(&u128Conversion{}, t)
+}
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"math"
 	"math/big"
+	"math/bits"
 	"strconv"
 	"sync"
 )
@@ -20,6 +21,10 @@ var ErrOverflow = errors.New("ints: dec: overflow")
 // argument is zero.
 var ErrDividedByZero = errors.New("intdec: div: divided by zero")
 
+// ErrFractionals is returned if a context is created with a number of
+// fractionals it can't represent.
+var ErrFractionals = errors.New("ints: dec: fractionals out of range")
+
 // A UContext represents an environment to do arithmetic with
 // uint64-based decimals.  A UContext's zero value is NOT ready to use.
 // Create a new context with needed flags by calling [ints.UDec]'s
@@ -32,6 +37,8 @@ type UContext struct {
 	flags                  *flags
 	separator              rune
 	fmtSeparator           rune
+	grouping               rune
+	overflow               Overflow
 	integrals, fractionals int8
 	pow                    UDecimal
 	maxInts, maxFrcs       uint64
@@ -42,6 +49,11 @@ type UContext struct {
 	// Float provides a ready to use "floats-decimal-calculator".
 	Float *UFloats
 
+	// Big provides the arithmetic of [BigDecimal]-values sharing the
+	// context's flags which promotes results to arbitrary precision
+	// instead of failing with an [ErrOverflow].
+	Big *BigContext
+
 	// Max is the maximal representable Decimal value having a context's
 	// set arithmetic fractionals.
 	Max UDecimal
@@ -55,7 +67,7 @@ type UContext struct {
 // [TWO_FRACTIONALS], i.e. a [UDecimal.Str] representation's fractionals
 // are truncated at the second position and a comma is used as decimal
 // separator.  Respectively [UDecimal.Rnd] "rounds to even" to the second
-// position.  In the unlikely case that there are more format
+// position using the same separator.  In the unlikely case that there are more format
 // fractionals as arithmetic fractionals the string representation is
 // accordingly padded with zeros.
 //
@@ -74,25 +86,109 @@ var UDec = func() *UContext {
 // copies the respective flag set of given context.  In general if
 // a fractionals or a separator flag is omitted the respective flag of given
 // context is used.  Is more than one fractional or separator flag given
-// only one of them is used and it is undefined which one.
+// only one of them is used and it is undefined which one.  A rounding
+// mode flag like [HALF_UP] in the arithmetic flags defines how
+// superfluous fractionals of Mult, Div and the From conversions are
+// rounded; without one they are truncated while [UConvert.Cntx] rounds
+// to even.
+// Separators, grouping and overflow policy not given by flags are
+// copied from given context whereas the grouping is dropped if it
+// equals a separator given by flags; see [UContext.NewChecked] for
+// failing instead.
 func (c *UContext) New(art, fmt Flags) *UContext {
 	if c == nil || c.flags == nil {
 		return UDec.New(art, fmt)
 	}
-	cntx := &UContext{flags: c.flags.copy(art, fmt)}
-	initialize(cntx)
+	var a, f Flags
+	a.set(art)
+	f.set(fmt)
+	cntx, err := c.newContext(c.flagsOptions(a, f)...)
+	if err != nil { // grouping equals a separator given by flags
+		cntx, _ = c.newContext(append(c.flagsOptions(a, f),
+			func(o *options) error { o.grouping = 0; return nil })...)
+	}
+	return cntx
+}
+
+// NewChecked creates a new Context-instance like [UContext.New] does
+// but validates given flags like the corresponding options of
+// [NewContext], i.e. it fails with an [ErrOption] if more than one
+// separator or fractionals flag is given or if a resulting decimal
+// separator equals the grouping separator and with an
+// [ErrRoundingMode] if more than one rounding mode flag is given.
+func (c *UContext) NewChecked(art, fmt Flags) (*UContext, error) {
+	if c == nil || c.flags == nil {
+		return UDec.NewChecked(art, fmt)
+	}
+	return c.newContext(c.flagsOptions(art, fmt)...)
+}
+
+// MNewChecked is the 'Must'-variant of [UContext.NewChecked] which
+// panics if corresponding NewChecked-call fails.
+func (c *UContext) MNewChecked(art, fmt Flags) *UContext {
+	cntx, err := c.NewChecked(art, fmt)
+	if err != nil {
+		panic(err)
+	}
+	return cntx
+}
+
+// flagsOptions returns the options of NewContext corresponding to given
+// arithmetic and format flags.
+func (c *UContext) flagsOptions(art, fmt Flags) []Option {
+	oo := []Option{flagsOption(art, Separator, Fractionals)}
+	if r := art & ffRoundings; r != 0 {
+		oo = append(oo, Rounding(r))
+	}
+	if fmt&ffSeparators == 0 {
+		oo = append(oo, FmtSeparator(c.fmtSeparator))
+	}
+	return append(oo, flagsOption(fmt, FmtSeparator, FmtFractionals))
+}
+
+// NewFractionals creates a new Context-instance with given number of
+// fractionals and provided arithmetic and format flags which are
+// handled like the flags of [UContext.New] while the arithmetic flags'
+// fractionals are ignored.  NewFractionals fails with an
+// [ErrFractionals] if given fractionals are negative or greater than
+// [MaxFractionals].
+//
+//	qty, err := ints.UDec.NewFractionals(0, DEFAULTS, DEFAULTS)
+func (c *UContext) NewFractionals(
+	fractionals int, art, fmt Flags,
+) (*UContext, error) {
+	f, err := FractionalsFlag(fractionals)
+	if err != nil {
+		return nil, err
+	}
+	return c.New(art&^ffFractionals|f, fmt), nil
+}
+
+// MNewFractionals is the 'Must'-variant of [UContext.NewFractionals]
+// which panics if corresponding NewFractionals-call fails.
+func (c *UContext) MNewFractionals(fractionals int, art, fmt Flags) *UContext {
+	cntx, err := c.NewFractionals(fractionals, art, fmt)
+	if err != nil {
+		panic(err)
+	}
 	return cntx
 }
 
 func initialize(c *UContext) {
-	c.separator = flagsToSeparator[c.flags.art&ffSeparators]
-	c.fmtSeparator = flagsToSeparator[c.flags.fmt&ffSeparators]
+	if s := flagsToSeparator[c.flags.art&ffSeparators]; s != 0 {
+		c.separator = s
+	}
+	if s := flagsToSeparator[c.flags.fmt&ffSeparators]; s != 0 {
+		c.fmtSeparator = s
+	}
 	c.integrals, c.fractionals, c.Max, c.maxInts, c.maxFrcs =
 		c.fractionalProperties(flagsToFractionals[c.flags.art&ffFractionals])
-	c.pow = UDecimal(math.Pow10(int(c.fractionals)))
+	c.pow = UDecimal(pow10(int(c.fractionals)))
 	c.flags.cntx = c
 	c.From = &UConvert{cntx: c}
 	c.Float = &UFloats{cntx: c}
+	c.Big = &BigContext{cntx: c}
+	c.Big.From = &BigConvert{cntx: c.Big}
 }
 
 func (c *UContext) fractionalProperties(n int) (
@@ -102,15 +198,12 @@ func (c *UContext) fractionalProperties(n int) (
 		return ii.ii, ii.ff, ii.max, ii.imx, ii.fmx
 	}
 	vv := nInit{ff: int8(n)}
-	expFrc := uint64(math.Pow10(int(vv.ff)))
+	expFrc := pow10(int(vv.ff))
 	var maxDifFrc uint64 = math.MaxUint64 - math.MaxUint64%expFrc
-	if maxDifFrc%(expFrc*10) == 0 {
-		panic("intdec: context: properties: unexpected zero-position")
-	}
 	vv.max = UDecimal(maxDifFrc - 1)
 	vv.imx = (maxDifFrc / expFrc) - 1
 	vv.fmx = uint64(vv.max) % expFrc
-	vv.ii = int8(len(strconv.Itoa(int(vv.imx))))
+	vv.ii = int8(len(strconv.FormatUint(vv.imx, 10)))
 	addInitsFor(n, vv)
 	return vv.ii, vv.ff, vv.max, vv.imx, vv.fmx
 }
@@ -146,15 +239,30 @@ var mutex = sync.Mutex{}
 
 // SetFmt sets given context's format flags.  Is more than one fractional
 // or separator flag given only one of them is used and it is undefined
-// which one.
+// which one.  The context's grouping is dropped if it equals the set
+// separator.
 func (c *UContext) SetFmt(ff Flags) {
 	c.flags.fmt.set(ff)
+	if ff&ffSeparators == 0 {
+		return
+	}
 	c.fmtSeparator = flagsToSeparator[c.flags.fmt&ffSeparators]
+	if c.fmtSeparator == c.grouping {
+		c.grouping = 0
+	}
 }
 
 // Add adds given decimals and returns their sum.  Add fails if the
-// result overflows given context's Max property.
+// result overflows given context's Max property unless the context
+// saturates overflows (see [OnOverflow]).
 func (c *UContext) Add(a, b UDecimal) (UDecimal, error) {
+	sum, err := c.add(a, b)
+	return c.saturate(sum, err, c.Max)
+}
+
+// add adds given decimals failing if their sum exceeds the context's
+// Max-property regardless of its overflow policy.
+func (c *UContext) add(a, b UDecimal) (UDecimal, error) {
 	if a > c.Max-b {
 		return 0, ErrOverflow
 	}
@@ -172,10 +280,11 @@ func (c *UContext) MAdd(a, b UDecimal) UDecimal {
 }
 
 // Sub subtracts given decimal b from a and returns their difference.
-// Sub fails with an overflow error if b is  greater than a.
+// Sub fails with an overflow error if b is  greater than a unless the
+// context saturates overflows in which case zero is returned.
 func (c *UContext) Sub(a, b UDecimal) (UDecimal, error) {
 	if b > a {
-		return 0, ErrOverflow
+		return c.saturate(0, ErrOverflow, 0)
 	}
 	return a - b, nil
 }
@@ -191,8 +300,18 @@ func (c *UContext) MSub(a, b UDecimal) UDecimal {
 }
 
 // Mult multiplies given decimals and returns their product.  Mult fails
-// if the product is greater than Max of given Context.
+// if the product is greater than Max of given Context unless the
+// context saturates overflows.  Superfluous fractionals are rounded
+// according to the context's rounding mode; without a rounding mode
+// they are truncated.
 func (c *UContext) Mult(a, b UDecimal) (UDecimal, error) {
+	prd, err := c.mult(a, b, false)
+	return c.saturate(prd, err, c.Max)
+}
+
+// mult multiplies given magnitudes of a product which is negative if
+// neg is true.
+func (c *UContext) mult(a, b UDecimal, neg bool) (UDecimal, error) {
 	if a == 0 || b == 0 {
 		return 0, nil
 	}
@@ -210,11 +329,15 @@ func (c *UContext) Mult(a, b UDecimal) (UDecimal, error) {
 		return 0, ErrOverflow
 	}
 	prodABWithoutProdFractionals := prodABInts + prodAIntsBFrc
-	prodAFrcBFrc := ((a % c.pow) * bFrc) / c.pow
-	if prodABWithoutProdFractionals > c.Max-prodAFrcBFrc {
+	// a's and b's fractionals product may exceed 64 bit for more than
+	// nine fractionals
+	hi, lo := bits.Mul64(uint64(a%c.pow), uint64(bFrc))
+	prodAFrcBFrc, rem := bits.Div64(hi, lo, uint64(c.pow))
+	if prodABWithoutProdFractionals > c.Max-UDecimal(prodAFrcBFrc) {
 		return 0, ErrOverflow
 	}
-	return prodABWithoutProdFractionals + prodAFrcBFrc, nil
+	return c.round(prodABWithoutProdFractionals+UDecimal(prodAFrcBFrc),
+		UDecimal(rem), c.pow, DOWN, neg)
 }
 
 // MMult is the 'Must'-variant of [UContext.Mult] which panics if
@@ -228,8 +351,18 @@ func (c *UContext) MMult(a, b UDecimal) UDecimal {
 }
 
 // Div divides a by b and returns resulting quotient.  Div fails if it
-// overflows (i.e. a is "big" and 0 < b < 1) or if b is zero.
+// overflows (i.e. a is "big" and 0 < b < 1) unless the context
+// saturates overflows or if b is zero.  The quotient is rounded
+// according to the context's rounding mode; without a rounding mode it
+// is truncated.
 func (c *UContext) Div(a, b UDecimal) (UDecimal, error) {
+	qut, err := c.div(a, b, false)
+	return c.saturate(qut, err, c.Max)
+}
+
+// div divides given magnitudes of a quotient which is negative if neg
+// is true.
+func (c *UContext) div(a, b UDecimal, neg bool) (UDecimal, error) {
 	if b == 0 {
 		return 0, ErrDividedByZero
 	}
@@ -245,9 +378,9 @@ func (c *UContext) Div(a, b UDecimal) (UDecimal, error) {
 	}
 	num := a * c.pow
 	if num/c.pow != a {
-		return c.bigIntDiv(a, b)
+		return c.bigIntDiv(a, b, neg)
 	}
-	return num / b, nil
+	return c.round(num/b, num%b, b, DOWN, neg)
 }
 
 // MDiv is the 'Must'-variant of [UContext.Div] which panics if
@@ -262,12 +395,14 @@ func (c *UContext) MDiv(a, b UDecimal) UDecimal {
 
 const maxInt64 = UDecimal(math.MaxInt64)
 
-func (c *UContext) bigIntDiv(a, b UDecimal) (UDecimal, error) {
+func (c *UContext) bigIntDiv(a, b UDecimal, neg bool) (UDecimal, error) {
 	bigA := (&big.Int{}).SetUint64(uint64(a))
 	bigA.Mul(bigA, big.NewInt(int64(c.pow)))
-	bigA.Div(bigA, (&big.Int{}).SetUint64(uint64(b)))
+	rem := &big.Int{}
+	bigA.QuoRem(bigA, (&big.Int{}).SetUint64(uint64(b)), rem)
 	if !bigA.IsUint64() {
 		return 0, ErrOverflow
 	}
-	return UDecimal(bigA.Uint64()), nil
+	return c.round(UDecimal(bigA.Uint64()), UDecimal(rem.Uint64()), b,
+		DOWN, neg)
 }
@@ -80,6 +80,77 @@ func (s *context) Provides_default_copy_if_new_from_nil_or_zero(t *T) {
 	t.Eq(UDec.flags.FmtFractionals(), got.flags.FmtFractionals())
 }
 
+func (s *context) Supports_zero_to_eighteen_fractionals(t *T) {
+	for n := 0; n <= MaxFractionals; n++ {
+		f, err := FractionalsFlag(n)
+		t.FatalOn(err)
+		dec := UDec.New(f, DEFAULTS)
+		t.Eq(n, dec.flags.Fractionals())
+		t.Eq(int8(n), dec.fractionals)
+		t.Eq(UDecimal(pow10(n)), dec.pow)
+		t.Eq(dec.maxInts, uint64(dec.Max.Integrals(dec)))
+		t.Eq(dec.maxFrcs, uint64(dec.Max.Fractionals(dec)))
+		t.Eq(int8(len(strconv.FormatUint(dec.maxInts, 10))),
+			dec.integrals)
+		t.Eq(n, UDec.MNewFractionals(n, DEFAULTS, DEFAULTS).
+			flags.Fractionals())
+	}
+}
+
+func (s *context) Fails_creation_with_fractionals_out_of_range(t *T) {
+	_, err := UDec.NewFractionals(-1, DEFAULTS, DEFAULTS)
+	t.ErrIs(err, ErrFractionals)
+	_, err = UDec.NewFractionals(MaxFractionals+1, DEFAULTS, DEFAULTS)
+	t.ErrIs(err, ErrFractionals)
+	_, err = FractionalsFlag(MaxFractionals + 1)
+	t.ErrIs(err, ErrFractionals)
+	t.Panics(func() { UDec.MNewFractionals(-1, DEFAULTS, DEFAULTS) })
+}
+
+func (s *context) Creation_with_fractionals_ignores_fractional_flags(t *T) {
+	dec := UDec.MNewFractionals(9, COMMA_SEPARATOR|TWO_FRACTIONALS,
+		ZERO_FRACTIONALS)
+	t.Eq(9, dec.flags.Fractionals())
+	t.Eq(',', dec.separator)
+	t.Eq(0, dec.flags.FmtFractionals())
+}
+
+func (s *context) Has_integer_context_with_zero_fractionals(t *T) {
+	qty := UDec.New(ZERO_FRACTIONALS, ZERO_FRACTIONALS)
+	t.Eq(UDecimal(math.MaxUint64-1), qty.Max)
+	t.Eq(UDecimal(42), qty.From.MStr("42"))
+	t.Eq(UDecimal(42), qty.From.MStr("42.9"))
+	t.Eq(UDecimal(42), qty.From.MInts(42, 0, 0))
+	t.Eq(UDecimal(42), qty.From.MFloat(42.9))
+	t.Eq(UDecimal(42), qty.MMult(6, 7))
+	t.Eq(UDecimal(6), qty.MDiv(42, 7))
+	t.Eq(UDecimal(2), qty.MDiv(5, 2))
+	t.Eq("42", UDecimal(42).Str(qty))
+	t.Eq("42", UDecimal(42).Rnd(qty))
+	qty.SetFmt(TWO_FRACTIONALS)
+	t.Eq("42,00", UDecimal(42).Str(qty))
+	t.Eq(UDecimal(3_000_000), qty.From.MCntx(3_000_000, qty))
+	t.Eq(UDecimal(3), qty.From.MCntx(3_499_999, UDec))
+}
+
+func (s *context) Has_nine_fractionals_context_for_fx_rates(t *T) {
+	fx := UDec.New(NINE_FRACTIONALS, NINE_FRACTIONALS)
+	rate := fx.From.MStr("1.087654321")
+	t.Eq(UDecimal(1_087_654_321), rate)
+	t.Eq("1,087654321", rate.Str(fx))
+	t.Eq(fx.From.MStr("108.7654321"), fx.MMult(rate, fx.From.MStr("100")))
+	t.Eq(fx.From.MStr("1.182991921"), fx.MMult(rate, rate))
+}
+
+func (s *context) Multiplies_fractionals_exceeding_64_bit(t *T) {
+	dec := UDec.New(EIGHTEEN_FRACTIONALS, DEFAULTS)
+	a := dec.From.MStr("1.999999999999999999")
+	t.Eq(dec.From.MStr("3.999999999999999996"), dec.MMult(a, a))
+	dec = UDec.New(EIGHTEEN_FRACTIONALS|HALF_UP, DEFAULTS)
+	t.Eq(dec.From.MStr("0.25"), dec.MMult(dec.From.MStr("0.5"),
+		dec.From.MStr("0.5")))
+}
+
 func TestContext(t *testing.T) {
 	t.Parallel()
 	Run(&context{}, t)
@@ -28,9 +28,30 @@ type UConvert struct {
 // substring is interpreted as the integrals while the second is
 // interpreted as the fractionals.  Str fails if resulting Decimal is
 // greater than associated [UContext]'s Max-property while superfluous
-// fractionals are truncated.  Str also fails if
+// fractionals are rounded according to the context's rounding mode;
+// without a rounding mode they are truncated.  Str also fails if
 // integrals- or fractionals-parsing fails.
-func (c UConvert) Str(s string) (_ UDecimal, err error) {
+func (c UConvert) Str(s string) (UDecimal, error) {
+	return c.str(s, false)
+}
+
+// str converts given string to the magnitude of a decimal which is
+// negative if neg is true.
+func (c UConvert) str(s string, neg bool) (UDecimal, error) {
+	d, err := c.parse(s)
+	if err != nil {
+		return 0, err
+	}
+	_, ff, ok := strings.Cut(s, string(c.cntx.separator))
+	if n := int(c.cntx.fractionals); ok && len(ff) > n {
+		return c.cntx.roundDigits(d, ff[n:], neg)
+	}
+	return d, nil
+}
+
+// parse converts given string to a decimal truncating superfluous
+// fractionals.
+func (c UConvert) parse(s string) (_ UDecimal, err error) {
 	if s == "" {
 		return 0, nil
 	}
@@ -42,6 +63,13 @@ func (c UConvert) Str(s string) (_ UDecimal, err error) {
 		return c.combine(i, 0, 0)
 	}
 	ifStr := strings.SplitN(s, string(c.cntx.separator), 2)
+	if n := int(c.cntx.fractionals); len(ifStr[1]) > n {
+		if strings.Trim(ifStr[1][n:], "0123456789") != "" {
+			return 0, &strconv.NumError{Func: "ParseUint",
+				Num: ifStr[1], Err: strconv.ErrSyntax}
+		}
+		ifStr[1] = ifStr[1][:n]
+	}
 	var iUint, fUint uint64
 	if ifStr[0] == "" {
 		iUint = 0
@@ -75,8 +103,19 @@ func (c UConvert) MStr(s string) UDecimal {
 // Float converts given float to a [UDecimal]-value by extracting its
 // integrals and fractionals.  It overflows if resulting Decimal is
 // greater than associated [UContext]'s Max-property while superfluous
-// fractionals are truncated.
+// fractionals are truncated.  Has the context a rounding mode the
+// float's shortest decimal representation is rounded according to it.
 func (c UConvert) Float(f float64) (UDecimal, error) {
+	return c.float(f, false)
+}
+
+// float converts given non-negative float to the magnitude of a decimal
+// which is negative if neg is true.
+func (c UConvert) float(f float64, neg bool) (UDecimal, error) {
+	if c.cntx.flags.art&ffRoundings != 0 {
+		return c.str(strings.Replace(strconv.FormatFloat(f, 'f', -1, 64),
+			".", string(c.cntx.separator), 1), neg)
+	}
 	unit := math.Pow10(int(c.cntx.fractionals + 1))
 	fInt, fFrc := math.Modf(f)
 	fFrc = math.Round(fFrc*unit) / 10
@@ -100,17 +139,31 @@ func (c UConvert) MFloat(f float64) UDecimal {
 // Ints converts given integrals, fractionals and leading zeros lz of
 // the fractionals to a [UDecimal]-value.  It overflows if resulting
 // Decimal is greater than associated [UContext]'s Max-property while
-// superfluous fractionals are truncated.
+// superfluous fractionals are rounded according to the context's
+// rounding mode; without a rounding mode they are truncated.
 func (c UConvert) Ints(
 	integrals, fractionals uint64, lz int,
+) (UDecimal, error) {
+	return c.ints(integrals, fractionals, lz, false)
+}
+
+// ints converts given integrals and fractionals to the magnitude of a
+// decimal which is negative if neg is true.
+func (c UConvert) ints(
+	integrals, fractionals uint64, lz int, neg bool,
 ) (UDecimal, error) {
 	if integrals == 0 && fractionals == 0 {
 		return 0, nil
 	}
 	len := c.len(fractionals)
-	if lz >= int(c.cntx.fractionals) {
+	if lz > 0 && lz >= int(c.cntx.fractionals) {
 		return 0, ErrOverflow
 	}
+	if c.cntx.flags.art&ffRoundings != 0 && fractionals != 0 {
+		return c.str(strconv.FormatUint(integrals, 10)+
+			string(c.cntx.separator)+strings.Repeat("0", lz)+
+			strconv.FormatUint(fractionals, 10), neg)
+	}
 	return c.combine(integrals, fractionals, len+lz)
 }
 
@@ -127,10 +180,20 @@ func (c UConvert) MInts(integrals, fractionals uint64, lz int) UDecimal {
 // Cntx converts given decimal of given context to a decimal of
 // receiving context.  Cntx fails if the conversion overflows.  If
 // receiving context has less fractionals than given then the
-// superfluous fractions are rounded evenly off (see UDecimal.Rnd).
+// superfluous fractions are rounded according to the receiving
+// context's rounding mode; without a rounding mode they are rounded
+// evenly off (see UDecimal.Rnd).
 func (c UConvert) Cntx(d UDecimal, cx *UContext) (UDecimal, error) {
+	return c.cntxConvert(d, cx, false)
+}
+
+// cntxConvert converts given magnitude of a decimal of given context
+// which is negative if neg is true.
+func (c UConvert) cntxConvert(
+	d UDecimal, cx *UContext, neg bool,
+) (UDecimal, error) {
 	if c.cntx.flags.Fractionals() < cx.flags.Fractionals() {
-		return c.rnd(d, cx)
+		return c.rnd(d, cx, neg)
 	}
 	pow := UDecimal(math.Pow10(
 		c.cntx.flags.Fractionals() - cx.flags.Fractionals()))
@@ -151,25 +214,16 @@ func (c UConvert) MCntx(d UDecimal, cx *UContext) UDecimal {
 	return d
 }
 
-// rnd rounds given decimal evenly down to an decimal of given
-// converters context with lesser fractionals.  NOTE there is no test
-// that given converter's context has less fractionals than given
-// context.  Since Cntx is the only caller of rnd it is not really
-// possible to test this case yet hence not test.
-func (c UConvert) rnd(d UDecimal, cx *UContext) (UDecimal, error) {
+// rnd rounds given decimal down to an decimal of given converters
+// context with lesser fractionals.  NOTE there is no test that given
+// converter's context has less fractionals than given context.  Since
+// Cntx is the only caller of rnd it is not really possible to test this
+// case yet hence not test.
+func (c UConvert) rnd(d UDecimal, cx *UContext, neg bool) (UDecimal, error) {
 	// to must be smaller than cx's fractionals
 	to := c.cntx.flags.Fractionals()
-	off := cx.flags.Fractionals() - to
-	pow, rest := UDecimal(math.Pow10(off)), UDecimal(0)
-	rnd, d := d%pow, d/pow
-	if off > 1 {
-		rest = rnd % (pow / 10)
-		rnd /= pow / 10
-	}
-	if rnd > 5 || (rnd == 5 && (rest > 0 || d%2 == 1)) {
-		d += 1
-	}
-	return d, nil
+	pow := UDecimal(math.Pow10(cx.flags.Fractionals() - to))
+	return c.cntx.round(d/pow, d%pow, pow, HALF_EVEN, neg)
 }
 
 func (c UConvert) len(a uint64) int {
@@ -6,6 +6,7 @@ package ints
 import (
 	"fmt"
 	"math"
+	"strconv"
 	"strings"
 )
 
@@ -48,27 +49,78 @@ func (d UDecimal) Integrals(c *UContext) UDecimal {
 // settings of given context.  If ..._FRACTIONALS of given context's
 // format flags is smaller than its corresponding  arithmetic flag the
 // fractionals are accordingly truncated; is it bigger zeros are
-// accordingly padded.  See [UDecimal.Rnd] for a rounded string
-// representation
+// accordingly padded.  Has the context a grouping separator (see
+// [Grouping]) the integrals are grouped by thousands.  See
+// [UDecimal.Rnd] for a rounded string representation
 func (d UDecimal) Str(c *UContext) string {
-	ii, ff, prePad := d.Integrals(c), d.Fractionals(c), ""
+	return d.truncated(c).format(c.fmtSeparator, c.grouping, 3, 3)
+}
+
+// fmtDigits represents a decimal's integrals and fractionals prepared
+// for its string representation having given number of fractional
+// positions and pad trailing zeros.
+type fmtDigits struct {
+	ii, ff           UDecimal
+	fractionals, pad int
+}
+
+// truncated returns given decimal's digits truncated or padded to the
+// format fractionals of given context.
+func (d UDecimal) truncated(c *UContext) fmtDigits {
+	ii, ff := d.Integrals(c), d.Fractionals(c)
 	cf, sf := c.flags.Fractionals(), c.flags.FmtFractionals()
-	ln := ff.Len()
-	if ln < cf { // need to pad zeros
-		prePad = strings.Repeat("0", cf-ln)
+	if sf < cf {
+		ff /= UDecimal(pow10(cf - sf))
+		cf = sf
 	}
-	if sf >= cf {
-		sufPad := strings.Repeat("0", sf-cf)
-		return fmt.Sprintf("%d%c%s%d%s", ii, c.fmtSeparator, prePad, ff, sufPad)
+	return fmtDigits{ii: ii, ff: ff, fractionals: cf, pad: sf - cf}
+}
+
+// format returns given digits' integrals and fractionals separated by
+// given separator whereas the fractionals are zero-padded to the
+// digits' fractional positions and trailing zeros.  The separator is
+// omitted if there are no fractional positions.  Is given grouping
+// separator not zero the integrals are grouped (see [groupBy]).
+func (dd fmtDigits) format(
+	sep, grouping rune, primary, secondary int,
+) string {
+	iStr := groupBy(strconv.FormatUint(uint64(dd.ii), 10), grouping,
+		primary, secondary)
+	if dd.fractionals+dd.pad == 0 {
+		return iStr
 	}
-	for i := 0; i < cf-sf; i++ {
-		ff /= 10
+	frc := ""
+	if dd.fractionals > 0 {
+		frc = fmt.Sprintf("%0*d", dd.fractionals, dd.ff)
+	}
+	return fmt.Sprintf("%s%c%s%s", iStr, sep, frc,
+		strings.Repeat("0", dd.pad))
+}
+
+// group inserts given grouping separator between each three digits of
+// given digits counted from the right.
+func group(digits string, grouping rune) string {
+	return groupBy(digits, grouping, 3, 3)
+}
+
+// groupBy inserts given grouping separator into given digits whereas
+// the rightmost group has primary digits and all other groups have
+// secondary digits, e.g. 3 and 2 for "12,34,567".
+func groupBy(digits string, grouping rune, primary, secondary int) string {
+	if grouping == 0 || len(digits) <= primary {
+		return digits
 	}
-	if ff == 0 {
-		return fmt.Sprintf("%d%c%s", ii, c.fmtSeparator,
-			strings.Repeat("0", sf))
+	head, tail := digits[:len(digits)-primary], digits[len(digits)-primary:]
+	var b strings.Builder
+	for i, d := range head {
+		if i > 0 && (len(head)-i)%secondary == 0 {
+			b.WriteRune(grouping)
+		}
+		b.WriteRune(d)
 	}
-	return fmt.Sprintf("%d%c%s%d", ii, c.fmtSeparator, prePad, ff)
+	b.WriteRune(grouping)
+	b.WriteString(tail)
+	return b.String()
 }
 
 // Rnd returns a rounded to even string representation of given value
@@ -86,10 +138,17 @@ func (d UDecimal) Str(c *UContext) string {
 //   - d == 5 and there are no non-null positions after d:
 //     v' is returned if v' is even; otherwise v'+0.x1 is returned
 func (d UDecimal) Rnd(c *UContext) string {
+	return d.rounded(c).format(c.fmtSeparator, c.grouping, 3, 3)
+}
+
+// rounded returns given decimal's digits rounded to even to the format
+// fractionals of given context iff they are smaller than its arithmetic
+// fractionals; otherwise the digits are padded.
+func (d UDecimal) rounded(c *UContext) fmtDigits {
 	nf := c.flags.FmtFractionals()
 	off := c.flags.Fractionals() - nf
 	if off <= 0 {
-		return d.Str(c)
+		return d.truncated(c)
 	}
 	pow, rest := UDecimal(math.Pow10(off)), UDecimal(0)
 	rnd, d := d%pow, d/pow
@@ -101,18 +160,5 @@ func (d UDecimal) Rnd(c *UContext) string {
 		d += 1
 	}
 	pow = UDecimal(math.Pow10(nf))
-	ff, _nf := d%pow, 0
-	if ff == 0 {
-		return fmt.Sprintf("%d%c%s",
-			d/pow, c.separator, strings.Repeat("0", nf))
-	}
-	for ff != 0 {
-		_nf++
-		ff /= 10
-	}
-	if _nf < nf {
-		return fmt.Sprintf("%d%c%s%d",
-			d/pow, c.separator, strings.Repeat("0", nf-_nf), d%pow)
-	}
-	return fmt.Sprintf("%d%c%d", d/pow, c.separator, d%pow)
+	return fmtDigits{ii: d / pow, ff: d % pow, fractionals: nf}
 }
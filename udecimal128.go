@@ -0,0 +1,203 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package ints
+
+import (
+	"math/bits"
+	"strings"
+)
+
+// UDecimal128 value represents together with a [U128Context]-instance
+// a non-negative decimal value of an unsigned 128 bit integer whose
+// high and low 64 bits are Hi and Lo.  Its last n positions are
+// interpreted as the decimal's fractionals whereas n is defined by the
+// context.  See [UDecimal] for the relation between a value and its
+// context.
+type UDecimal128 struct{ Hi, Lo uint64 }
+
+// IsZero returns true if given decimal is zero.
+func (d UDecimal128) IsZero() bool { return d.Hi == 0 && d.Lo == 0 }
+
+// Cmp compares given decimals d and e and returns -1 if d < e, 0 if
+// d == e and +1 if d > e.
+func (d UDecimal128) Cmp(e UDecimal128) int {
+	switch {
+	case d.Hi < e.Hi || d.Hi == e.Hi && d.Lo < e.Lo:
+		return -1
+	case d.Hi > e.Hi || d.Lo > e.Lo:
+		return 1
+	}
+	return 0
+}
+
+// Integrals returns a decimal's integral part relative to provided
+// context's fractionals.
+func (d UDecimal128) Integrals(c *U128Context) UDecimal128 {
+	q, _ := divWords64(d.words(), c.pow)
+	return UDecimal128{Hi: q[1], Lo: q[0]}
+}
+
+// Fractionals returns a decimal's fractional part relative to provided
+// context's fractionals.
+func (d UDecimal128) Fractionals(c *U128Context) uint64 {
+	_, r := divWords64(d.words(), c.pow)
+	return r
+}
+
+// Str returns a string representation of given value relative to the
+// settings of given context.  Fractionals are truncated or padded with
+// zeros to the context's format fractionals as [UDecimal.Str] does.
+func (d UDecimal128) Str(c *U128Context) string {
+	if c.fmtFractionals >= c.fractionals {
+		return c.format(d, c.fractionals, c.fmtFractionals-c.fractionals)
+	}
+	q, _ := divWords64(d.words(), pow10(c.fractionals-c.fmtFractionals))
+	return c.format(UDecimal128{Hi: q[1], Lo: q[0]}, c.fmtFractionals, 0)
+}
+
+// Rnd returns a rounded to even string representation of given value
+// iff the format fractionals of given context are smaller than its
+// fractionals; see [UDecimal.Rnd].
+func (d UDecimal128) Rnd(c *U128Context) string {
+	if c.fmtFractionals >= c.fractionals {
+		return d.Str(c)
+	}
+	r, _ := rescale128(d, c.fractionals, c.fmtFractionals)
+	return c.format(r, c.fmtFractionals, 0)
+}
+
+// dec returns the decimal digits of given decimal's underlying
+// integer.
+func (d UDecimal128) dec() string {
+	const chunk = 1e19
+	hi, lo := divWords64(d.words(), chunk)
+	hh, mid := divWords64(hi, chunk)
+	switch {
+	case hh[0] != 0:
+		return utoa(hh[0]) + pad19(mid) + pad19(lo)
+	case mid != 0:
+		return utoa(mid) + pad19(lo)
+	}
+	return utoa(lo)
+}
+
+func (d UDecimal128) words() []uint64 { return []uint64{d.Lo, d.Hi} }
+
+func utoa(u uint64) string {
+	if u == 0 {
+		return "0"
+	}
+	var bb [20]byte
+	i := len(bb)
+	for ; u > 0; u /= 10 {
+		i--
+		bb[i] = byte('0' + u%10)
+	}
+	return string(bb[i:])
+}
+
+func pad19(u uint64) string {
+	s := utoa(u)
+	return strings.Repeat("0", 19-len(s)) + s
+}
+
+// pow10 returns 10^n for 0 <= n <= 19.
+func pow10(n int) uint64 {
+	p := uint64(1)
+	for ; n > 0; n-- {
+		p *= 10
+	}
+	return p
+}
+
+func add128(a, b UDecimal128) (_ UDecimal128, overflow bool) {
+	lo, carry := bits.Add64(a.Lo, b.Lo, 0)
+	hi, carry := bits.Add64(a.Hi, b.Hi, carry)
+	return UDecimal128{Hi: hi, Lo: lo}, carry != 0
+}
+
+func sub128(a, b UDecimal128) UDecimal128 {
+	lo, borrow := bits.Sub64(a.Lo, b.Lo, 0)
+	hi, _ := bits.Sub64(a.Hi, b.Hi, borrow)
+	return UDecimal128{Hi: hi, Lo: lo}
+}
+
+// mulWords returns the product of given little-endian word slices.
+func mulWords(a, b []uint64) []uint64 {
+	p := make([]uint64, len(a)+len(b))
+	for i, x := range a {
+		var carry uint64
+		for j, y := range b {
+			hi, lo := bits.Mul64(x, y)
+			var c uint64
+			lo, c = bits.Add64(lo, p[i+j], 0)
+			hi += c
+			lo, c = bits.Add64(lo, carry, 0)
+			hi += c
+			p[i+j], carry = lo, hi
+		}
+		p[i+len(b)] = carry
+	}
+	return p
+}
+
+// divWords64 returns the quotient and remainder of given little-endian
+// words divided by given non-zero divisor.
+func divWords64(n []uint64, d uint64) ([]uint64, uint64) {
+	q, r := make([]uint64, len(n)), uint64(0)
+	for i := len(n) - 1; i >= 0; i-- {
+		q[i], r = bits.Div64(r, n[i], d)
+	}
+	return q, r
+}
+
+// divWords returns the quotient and remainder of given little-endian
+// words divided by given non-zero divisor.  A divisor exceeding 64 bit
+// is handled by a binary long division.
+func divWords(n []uint64, d UDecimal128) ([]uint64, UDecimal128) {
+	if d.Hi == 0 {
+		q, r := divWords64(n, d.Lo)
+		return q, UDecimal128{Lo: r}
+	}
+	q, r := make([]uint64, len(n)), UDecimal128{}
+	for i := 64*len(n) - 1; i >= 0; i-- {
+		carry := r.Hi >> 63
+		r = UDecimal128{Hi: r.Hi<<1 | r.Lo>>63,
+			Lo: r.Lo<<1 | n[i/64]>>(i%64)&1}
+		if carry == 1 || r.Cmp(d) >= 0 {
+			r = sub128(r, d)
+			q[i/64] |= 1 << (i % 64)
+		}
+	}
+	return q, r
+}
+
+// fits128 returns given words as UDecimal128 and false iff they
+// exceed 128 bit.
+func fits128(w []uint64) (UDecimal128, bool) {
+	for _, x := range w[2:] {
+		if x != 0 {
+			return UDecimal128{}, false
+		}
+	}
+	return UDecimal128{Hi: w[1], Lo: w[0]}, true
+}
+
+// rescale128 returns given value with from fractionals as a value with
+// to fractionals whereas superfluous fractionals are rounded to even.
+// It returns false if the result exceeds 128 bit.
+func rescale128(d UDecimal128, from, to int) (UDecimal128, bool) {
+	if to >= from {
+		return fits128(mulWords(d.words(), []uint64{pow10(to - from)}))
+	}
+	pow := pow10(from - to)
+	q, r := divWords64(d.words(), pow)
+	v := UDecimal128{Hi: q[1], Lo: q[0]}
+	if r > pow/2 || r == pow/2 && v.Lo%2 == 1 {
+		v, overflow := add128(v, UDecimal128{Lo: 1})
+		return v, !overflow
+	}
+	return v, true
+}
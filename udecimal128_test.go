@@ -0,0 +1,56 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package ints
+
+import (
+	"testing"
+
+	. "github.com/slukits/gounit"
+)
+
+type uDecimal128 struct{ Suite }
+
+func (s *uDecimal128) SetUp(t *T) { t.Parallel() }
+
+func (s *uDecimal128) Compares_decimals(t *T) {
+	a, b := UDecimal128{Hi: 1}, UDecimal128{Lo: 1<<64 - 1}
+	t.Eq(1, a.Cmp(b))
+	t.Eq(-1, b.Cmp(a))
+	t.Eq(0, a.Cmp(a))
+	t.True(UDecimal128{}.IsZero())
+	t.Not.True(a.IsZero())
+}
+
+func (s *uDecimal128) Provides_integral_and_fractional_part(t *T) {
+	d := UDec128.From.MStr("42000000000000000000.000000000000000042")
+	t.Eq("42000000000000000000", d.Integrals(UDec128).dec())
+	t.Eq(uint64(42), d.Fractionals(UDec128))
+}
+
+func (s *uDecimal128) String_truncates_or_pads_fractionals(t *T) {
+	dec := UDec128.MNew(3, DEFAULTS, DOT_SEPARATOR|FOUR_FRACTIONALS)
+	t.Eq("1.2340", dec.From.MStr("1.234").Str(dec))
+	t.Eq("0.0010", dec.From.MStr(".001").Str(dec))
+	dec.SetFmt(TWO_FRACTIONALS)
+	t.Eq("1.23", dec.From.MStr("1.239").Str(dec))
+	t.Eq("0.00", dec.From.MStr("0.009").Str(dec))
+	zero := UDec128.MNew(0, DEFAULTS, DEFAULTS)
+	t.Eq("42,00", zero.From.MStr("42").Str(zero))
+}
+
+func (s *uDecimal128) Rounds_to_even(t *T) {
+	dec := UDec128.MNew(3, DEFAULTS, DOT_SEPARATOR|TWO_FRACTIONALS)
+	t.Eq("1.24", dec.From.MStr("1.239").Rnd(dec))
+	t.Eq("1.24", dec.From.MStr("1.235").Rnd(dec))
+	t.Eq("1.24", dec.From.MStr("1.245").Rnd(dec))
+	t.Eq("0.01", dec.From.MStr("0.006").Rnd(dec))
+	dec.SetFmt(FOUR_FRACTIONALS)
+	t.Eq("1.2390", dec.From.MStr("1.239").Rnd(dec))
+}
+
+func TestUDecimal128(t *testing.T) {
+	t.Parallel()
+	Run(&uDecimal128{}, t)
+}
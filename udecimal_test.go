@@ -44,6 +44,27 @@ func (s *decimal) String_pads_suffixing_fractional_zeros(t *T) {
 	t.Eq("3.20000000", dec.From.MFloat(3.2).Str(dec))
 }
 
+func (s *decimal) String_pads_zero_fractionals(t *T) {
+	dec := UDec.New(TWO_FRACTIONALS, TWO_FRACTIONALS|DOT_SEPARATOR)
+	t.Eq("3.00", dec.From.MStr("3").Str(dec))
+	t.Eq("0.00", UDecimal(0).Str(dec))
+}
+
+func (s *decimal) String_omits_decimal_mark_without_fractionals(t *T) {
+	dec := UDec.New(DEFAULTS, ZERO_FRACTIONALS)
+	t.Eq("3", dec.From.MStr("3.99").Str(dec))
+	t.Eq("4", dec.From.MStr("3.99").Rnd(dec))
+}
+
+func (s *decimal) Rounds_with_format_separator(t *T) {
+	dec := UDec.New(DOT_SEPARATOR, COMMA_SEPARATOR|EIGHT_FRACTIONALS)
+	t.Eq("1,50000000", dec.From.MStr("1.5").Rnd(dec))
+	t.Eq("1,50000000", dec.From.MStr("1.5").Str(dec))
+	dec = UDec.New(DOT_SEPARATOR, COMMA_SEPARATOR|TWO_FRACTIONALS)
+	t.Eq("1,50", dec.From.MStr("1.5").Rnd(dec))
+	t.Eq("1,50", dec.From.MStr("1.5").Str(dec))
+}
+
 func (s *decimal) Rounds_to_even(t *T) {
 	dec := UDec.New(DEFAULTS, EIGHT_FRACTIONALS|DOT_SEPARATOR)
 	t.Eq("3.19400000", dec.From.MFloat(3.194).Rnd(dec))
@@ -0,0 +1,137 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package ints
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// ErrInexact is returned by the exact conversions like
+// [UConvert.StrExact] if a converted number has more non-zero
+// fractionals than its context.  It carries the truncated decimal and
+// the lost digits which allows a caller to reject the number or to
+// round it (see [ErrInexact.Round]).
+type ErrInexact struct {
+
+	// Value is the converted decimal whose superfluous fractionals are
+	// truncated.
+	Value UDecimal
+
+	// Lost are the truncated fractional digits without trailing zeros
+	// following the last fractional position of the context, e.g. "7"
+	// for "0.1234567" in [UDec].
+	Lost string
+
+	cntx *UContext
+}
+
+func (e *ErrInexact) Error() string {
+	return fmt.Sprintf("ints: dec: inexact: lost fractionals %q", e.Lost)
+}
+
+// Round returns the inexact converted value rounded according to given
+// mode which is interpreted as for [UContext.Round].  It fails with an
+// [ErrOverflow] if the rounded value exceeds the context's
+// Max-property.
+func (e *ErrInexact) Round(mode Flags) (UDecimal, error) {
+	mode, err := e.cntx.roundingMode(mode)
+	if err != nil {
+		return 0, err
+	}
+	if !roundUp(mode, halfOfDigits(e.Lost), e.Value%2 == 1, false) {
+		return e.Value, nil
+	}
+	if e.Value >= e.cntx.Max {
+		return 0, ErrOverflow
+	}
+	return e.Value + 1, nil
+}
+
+// StrExact converts given string to a [UDecimal]-value as
+// [UConvert.Str] does but fails with an [ErrInexact] instead of
+// dropping non-zero superfluous fractionals.
+//
+//	_, err := ints.UDec.From.StrExact("0.1234567")
+//	var inexact *ints.ErrInexact
+//	if errors.As(err, &inexact) {
+//	    // reject or inexact.Round(ints.HALF_EVEN)
+//	}
+func (c UConvert) StrExact(s string) (UDecimal, error) {
+	d, err := c.parse(s)
+	if err != nil {
+		return 0, err
+	}
+	_, ff, ok := strings.Cut(s, string(c.cntx.separator))
+	if n := int(c.cntx.fractionals); ok && len(ff) > n &&
+		!isZeros(ff[n:]) {
+		return 0, &ErrInexact{Value: d,
+			Lost: strings.TrimRight(ff[n:], "0"), cntx: c.cntx}
+	}
+	return d, nil
+}
+
+// MStrExact is the "Must"-variant of [UConvert.StrExact] which panics
+// if corresponding StrExact-call fails.
+func (c UConvert) MStrExact(s string) UDecimal {
+	v, err := c.StrExact(s)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// FloatExact converts the shortest decimal representation of given
+// float to a [UDecimal]-value failing with an [ErrInexact] if it has
+// non-zero superfluous fractionals; see [UConvert.StrExact].  It fails
+// with an [ErrOverflow] if given float is negative, infinite or not a
+// number.
+func (c UConvert) FloatExact(f float64) (UDecimal, error) {
+	if f < 0 || math.IsInf(f, 0) || math.IsNaN(f) {
+		return 0, ErrOverflow
+	}
+	return c.StrExact(strings.Replace(strconv.FormatFloat(f, 'f', -1, 64),
+		".", string(c.cntx.separator), 1))
+}
+
+// MFloatExact is the "Must"-variant of [UConvert.FloatExact] which
+// panics if corresponding FloatExact-call fails.
+func (c UConvert) MFloatExact(f float64) UDecimal {
+	v, err := c.FloatExact(f)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// IntsExact converts given integrals, fractionals and leading zeros lz
+// of the fractionals to a [UDecimal]-value failing with an
+// [ErrInexact] if the fractionals don't fit into the context's
+// fractionals; see [UConvert.StrExact].
+func (c UConvert) IntsExact(
+	integrals, fractionals uint64, lz int,
+) (UDecimal, error) {
+	if lz < 0 {
+		return 0, ErrOverflow
+	}
+	ff := ""
+	if fractionals > 0 {
+		ff = string(c.cntx.separator) + strings.Repeat("0", lz) +
+			strconv.FormatUint(fractionals, 10)
+	}
+	return c.StrExact(strconv.FormatUint(integrals, 10) + ff)
+}
+
+// MIntsExact is the "Must"-variant of [UConvert.IntsExact] which panics
+// if corresponding IntsExact-call fails.
+func (c UConvert) MIntsExact(integrals, fractionals uint64, lz int) UDecimal {
+	v, err := c.IntsExact(integrals, fractionals, lz)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
@@ -0,0 +1,101 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package ints
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	. "github.com/slukits/gounit"
+)
+
+type exact struct{ Suite }
+
+func (s *exact) SetUp(t *T) { t.Parallel() }
+
+func inexact(t *T, err error) *ErrInexact {
+	t.GoT().Helper()
+	var e *ErrInexact
+	if !errors.As(err, &e) {
+		t.Fatalf("expected inexact error; got %v", err)
+	}
+	return e
+}
+
+func (s *exact) Converts_exact_numbers(t *T) {
+	for _, in := range []string{"0", "42", "0.123456", "1.5000000",
+		"18446744073708.999999"} {
+		t.Eq(UDec.From.MStr(in), UDec.From.MStrExact(in))
+	}
+	t.Eq(UDec.From.MStr("0.1"), UDec.From.MFloatExact(0.1))
+	t.Eq(UDec.From.MStr("1.000002"), UDec.From.MIntsExact(1, 2, 5))
+	t.Eq(UDec.From.MStr("1"), UDec.From.MIntsExact(1, 0, 0))
+}
+
+func (s *exact) Reports_lost_fractionals(t *T) {
+	_, err := UDec.From.StrExact("0.1234567")
+	e := inexact(t, err)
+	t.Eq(UDecimal(123456), e.Value)
+	t.Eq("7", e.Lost)
+	t.ErrMatched(err, `inexact: lost fractionals "7"`)
+	_, err = UDec.From.StrExact("0.123456005000")
+	t.Eq("005", inexact(t, err).Lost)
+	_, err = UDec.From.FloatExact(0.1234567)
+	t.Eq("7", inexact(t, err).Lost)
+	_, err = UDec.From.IntsExact(0, 1, 6)
+	e = inexact(t, err)
+	t.Eq(UDecimal(0), e.Value)
+	t.Eq("1", e.Lost)
+	t.Panics(func() { UDec.From.MStrExact("0.1234567") })
+	t.Panics(func() { UDec.From.MFloatExact(0.1234567) })
+	t.Panics(func() { UDec.From.MIntsExact(0, 1, 6) })
+}
+
+func (s *exact) Ignores_rounding_mode_of_context(t *T) {
+	dec := UDec.New(TWO_FRACTIONALS|HALF_UP, DEFAULTS)
+	_, err := dec.From.StrExact("1.005")
+	e := inexact(t, err)
+	t.Eq(UDecimal(100), e.Value)
+	t.Eq("5", e.Lost)
+}
+
+func (s *exact) Rounds_inexact_value_on_demand(t *T) {
+	dec := UDec.New(TWO_FRACTIONALS, DEFAULTS)
+	_, err := dec.From.StrExact("1.005")
+	e := inexact(t, err)
+	for mode, exp := range map[Flags]UDecimal{
+		HALF_EVEN: 100, HALF_UP: 101, DOWN: 100, UP: 101, DEFAULTS: 100,
+	} {
+		got, err := e.Round(mode)
+		t.FatalOn(err)
+		t.Eq(exp, got)
+	}
+	_, err = e.Round(TWO_FRACTIONALS)
+	t.ErrIs(err, ErrRoundingMode)
+	_, err = dec.From.StrExact("184467440737095515.991")
+	_, err = inexact(t, err).Round(UP)
+	t.ErrIs(err, ErrOverflow)
+}
+
+func (s *exact) Fails_like_inexact_counterparts(t *T) {
+	_, err := UDec.From.StrExact("1.2x")
+	t.ErrMatched(err, "invalid syntax")
+	_, err = UDec.From.StrExact("1.1234567x")
+	t.ErrMatched(err, "invalid syntax")
+	_, err = UDec.From.StrExact("18446744073709")
+	t.ErrIs(err, ErrOverflow)
+	for _, f := range []float64{-1, math.Inf(1), math.NaN()} {
+		_, err = UDec.From.FloatExact(f)
+		t.ErrIs(err, ErrOverflow)
+	}
+	_, err = UDec.From.IntsExact(1, 1, -1)
+	t.ErrIs(err, ErrOverflow)
+}
+
+func TestExact(t *testing.T) {
+	t.Parallel()
+	Run(&exact{}, t)
+}
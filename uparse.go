@@ -0,0 +1,338 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package ints
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ParseOptions enable the lenient parsing of [UConvert.Parse].  The
+// zero value accepts what [UConvert.Str] accepts except for input
+// without any digit like "" or the lone separator which Str converts to
+// zero while Parse rejects it; each leniency must be enabled
+// explicitly.
+type ParseOptions struct {
+
+	// Decimal is the accepted decimal separator; it defaults to the
+	// separator of the converter's context.
+	Decimal rune
+
+	// Grouping is an accepted separator between integral digits, e.g.
+	// '\'' for "1'234.50" or '.' for "1.234,50" with ',' as Decimal.
+	Grouping rune
+
+	// Primary is the number of digits of the rightmost group of a
+	// grouped number's integrals and Secondary the number of digits of
+	// all other groups while the leftmost group may be shorter; they
+	// default to 3 and Primary (see [Locale]).
+	Primary, Secondary int
+
+	// Space accepts white space surrounding the number and between the
+	// number and a currency.
+	Space bool
+
+	// Plus accepts a leading '+'.
+	Plus bool
+
+	// Currencies are accepted currency symbols or codes before or after
+	// the number, e.g. "CHF", "€" or "$".
+	Currencies []string
+
+	// Exponent accepts scientific notation like "1.5e3".
+	Exponent bool
+}
+
+// ParseOptions returns the options parsing numbers using given locale's
+// decimal mark and digit grouping.
+func (l Locale) ParseOptions() ParseOptions {
+	return ParseOptions{Decimal: l.Decimal, Grouping: l.Grouping,
+		Primary: l.Primary, Secondary: l.Secondary}
+}
+
+// A ParseError reports why and at which byte position [UConvert.Parse]
+// failed to parse its input.  It wraps [strconv.ErrSyntax].
+type ParseError struct {
+	Input string
+	Pos   int
+	Msg   string
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("ints: dec: parse %q: %s at position %d",
+		e.Input, e.Msg, e.Pos)
+}
+
+func (e *ParseError) Unwrap() error { return strconv.ErrSyntax }
+
+// Parse converts given string to a [UDecimal]-value accepting what
+// given options enable.  The parsed number is converted as
+// [UConvert.Str] does, i.e. superfluous fractionals are rounded
+// according to the context's rounding mode or truncated.  Parse fails
+// with a [ParseError] describing the first not accepted character and
+// with an [ErrOverflow] if the number exceeds the context's
+// Max-property.
+//
+//	opts := ints.ParseOptions{Grouping: '\'', Space: true,
+//	    Currencies: []string{"CHF"}}
+//	d, err := ints.UDec.From.Parse(" CHF 1'234.50", opts)
+func (c UConvert) Parse(s string, o ParseOptions) (UDecimal, error) {
+	p := parser{input: s, opts: o, end: len(s)}
+	if p.opts.Decimal == 0 {
+		p.opts.Decimal = c.cntx.separator
+	}
+	if p.opts.Grouping == p.opts.Decimal {
+		return 0, p.fail(0, "grouping equals decimal separator")
+	}
+	if p.opts.Primary <= 0 {
+		p.opts.Primary = 3
+	}
+	if p.opts.Secondary <= 0 {
+		p.opts.Secondary = p.opts.Primary
+	}
+	ii, ff, err := p.parse()
+	if err != nil {
+		return 0, err
+	}
+	if ii = strings.TrimLeft(ii, "0"); len(ii) > 20 {
+		return 0, ErrOverflow
+	}
+	if ff != "" {
+		ii += string(c.cntx.separator) + ff
+	}
+	d, err := c.str(ii, false)
+	if errors.Is(err, strconv.ErrRange) {
+		return 0, ErrOverflow
+	}
+	return d, err
+}
+
+// MParse is the "Must"-variant of [UConvert.Parse] which panics if
+// corresponding Parse-call fails.
+func (c UConvert) MParse(s string, o ParseOptions) UDecimal {
+	v, err := c.Parse(s, o)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// parser parses its input's bytes from start to end.
+type parser struct {
+	input      string
+	opts       ParseOptions
+	start, end int
+}
+
+func (p *parser) fail(pos int, format string, args ...interface{}) error {
+	return &ParseError{Input: p.input, Pos: pos,
+		Msg: fmt.Sprintf(format, args...)}
+}
+
+// parse returns the integral and fractional digits of the parser's
+// input having its exponent applied.
+func (p *parser) parse() (ii, ff string, err error) {
+	if err := p.trimSpace(); err != nil {
+		return "", "", err
+	}
+	if err := p.trimCurrency(); err != nil {
+		return "", "", err
+	}
+	if p.start < p.end && p.input[p.start] == '+' {
+		if !p.opts.Plus {
+			return "", "", p.fail(p.start, "unexpected sign '+'")
+		}
+		p.start++
+	}
+	if p.start < p.end && p.input[p.start] == '-' {
+		return "", "", p.fail(p.start, "unexpected sign '-'")
+	}
+	if p.start == p.end {
+		return "", "", p.fail(p.start, "missing digits")
+	}
+	ii, ff, exp, err := p.number()
+	if err != nil {
+		return "", "", err
+	}
+	ii, ff = shift(ii, ff, exp)
+	return ii, ff, nil
+}
+
+// trimSpace removes surrounding white space from the parsed range.
+func (p *parser) trimSpace() error {
+	s := p.input[p.start:p.end]
+	trimmed := strings.TrimLeftFunc(s, unicode.IsSpace)
+	lead := len(s) - len(trimmed)
+	trimmed = strings.TrimRightFunc(trimmed, unicode.IsSpace)
+	if !p.opts.Space && len(trimmed) != len(s) {
+		if lead > 0 {
+			return p.fail(p.start, "unexpected white space")
+		}
+		return p.fail(p.start+len(trimmed), "unexpected white space")
+	}
+	p.start, p.end = p.start+lead, p.start+lead+len(trimmed)
+	return nil
+}
+
+// trimCurrency removes a leading or trailing currency and white space
+// between currency and number from the parsed range.
+func (p *parser) trimCurrency() error {
+	for _, cur := range p.opts.Currencies {
+		if cur == "" {
+			continue
+		}
+		s := p.input[p.start:p.end]
+		switch {
+		case strings.HasPrefix(s, cur):
+			p.start += len(cur)
+		case strings.HasSuffix(s, cur):
+			p.end -= len(cur)
+		default:
+			continue
+		}
+		return p.trimSpace()
+	}
+	return nil
+}
+
+// number scans the parsed range for integral and fractional digits and
+// an exponent.
+func (p *parser) number() (ii, ff string, exp int, err error) {
+	var digits strings.Builder
+	frc, ints := -1, 0 // position of fractionals, number of integrals
+	last := rune(0)
+	g := groups{sep: -1}
+	for i := p.start; i < p.end; {
+		r, n := utf8.DecodeRuneInString(p.input[i:])
+		switch {
+		case '0' <= r && r <= '9':
+			digits.WriteRune(r)
+			if frc < 0 {
+				g.digits++
+			}
+		case r == p.opts.Decimal && frc < 0:
+			if last == p.opts.Grouping && last != 0 {
+				return "", "", 0, p.fail(i,
+					"decimal separator %q after grouping", r)
+			}
+			if err := p.lastGroup(g); err != nil {
+				return "", "", 0, err
+			}
+			frc, ints = i, digits.Len()
+		case r == p.opts.Decimal:
+			return "", "", 0, p.fail(i, "second decimal separator %q", r)
+		case r == p.opts.Grouping && r != 0:
+			if frc >= 0 {
+				return "", "", 0, p.fail(i,
+					"grouping %q in fractionals", r)
+			}
+			if last < '0' || last > '9' {
+				return "", "", 0, p.fail(i,
+					"grouping %q not preceded by a digit", r)
+			}
+			if g.n == 0 && g.digits > p.opts.Secondary ||
+				g.n > 0 && g.digits != p.opts.Secondary {
+				return "", "", 0, p.fail(i, "grouping %q after %d digits",
+					r, g.digits)
+			}
+			g = groups{n: g.n + 1, sep: i}
+		case (r == 'e' || r == 'E') && p.opts.Exponent:
+			if digits.Len() == 0 {
+				return "", "", 0, p.fail(i, "exponent without digits")
+			}
+			if last == p.opts.Grouping {
+				return "", "", 0, p.fail(i, "exponent after grouping")
+			}
+			if exp, err = p.exponent(i + n); err != nil {
+				return "", "", 0, err
+			}
+			p.end, last = i, '0'
+			continue
+		case unicode.IsSpace(r):
+			return "", "", 0, p.fail(i, "unexpected white space")
+		default:
+			return "", "", 0, p.fail(i, "unexpected %q", r)
+		}
+		last = r
+		i += n
+	}
+	if last == p.opts.Grouping && last != 0 {
+		return "", "", 0, p.fail(p.end-utf8.RuneLen(last),
+			"trailing grouping %q", last)
+	}
+	if digits.Len() == 0 {
+		return "", "", 0, p.fail(p.start, "missing digits")
+	}
+	if frc < 0 {
+		if err := p.lastGroup(g); err != nil {
+			return "", "", 0, err
+		}
+		return digits.String(), "", exp, nil
+	}
+	return digits.String()[:ints], digits.String()[ints:], exp, nil
+}
+
+// groups keeps track of the integral digit groups of a parsed number,
+// i.e. the number n of grouping separators, the offset sep of the last
+// one and the number of digits following it.
+type groups struct {
+	n, sep, digits int
+}
+
+// lastGroup fails if the number is grouped and the rightmost group of
+// integrals doesn't have the primary group size.
+func (p *parser) lastGroup(g groups) error {
+	if g.n == 0 || g.digits == p.opts.Primary {
+		return nil
+	}
+	return p.fail(g.sep, "grouping %q followed by %d digits",
+		p.opts.Grouping, g.digits)
+}
+
+// maxExponent bounds the absolute value of a parsed exponent.
+const maxExponent = 1000
+
+// exponent parses the exponent starting at given position up to the
+// end of the parsed range.
+func (p *parser) exponent(i int) (int, error) {
+	s := p.input[i:p.end]
+	if s == "" {
+		return 0, p.fail(i, "missing exponent")
+	}
+	if strings.Trim(s[:1], "+-") == "" {
+		s = s[1:]
+	}
+	if s == "" || strings.Trim(s, "0123456789") != "" {
+		return 0, p.fail(i, "invalid exponent %q", p.input[i:p.end])
+	}
+	exp, err := strconv.Atoi(p.input[i:p.end])
+	if err != nil || exp > maxExponent || exp < -maxExponent {
+		return 0, p.fail(i, "exponent %q out of range",
+			p.input[i:p.end])
+	}
+	return exp, nil
+}
+
+// shift moves the decimal point of given integral and fractional
+// digits by given exponent.
+func shift(ii, ff string, exp int) (string, string) {
+	switch {
+	case exp > 0:
+		if exp > len(ff) {
+			ff += strings.Repeat("0", exp-len(ff))
+		}
+		return ii + ff[:exp], ff[exp:]
+	case exp < 0:
+		if -exp > len(ii) {
+			ii = strings.Repeat("0", -exp-len(ii)) + ii
+		}
+		return ii[:len(ii)+exp], ii[len(ii)+exp:] + ff
+	}
+	return ii, ff
+}
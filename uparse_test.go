@@ -0,0 +1,162 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package ints
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	. "github.com/slukits/gounit"
+)
+
+type lenientParse struct{ Suite }
+
+func (s *lenientParse) SetUp(t *T) { t.Parallel() }
+
+func (s *lenientParse) Parses_like_str_without_options(t *T) {
+	for _, in := range []string{"0", "42", "42.5", ".5", "5.",
+		"1.2345678", "18446744073708.999999"} {
+		t.Eq(UDec.From.MStr(in), UDec.From.MParse(in, ParseOptions{}))
+	}
+}
+
+func (s *lenientParse) Accepts_grouping_separators(t *T) {
+	dec := UDec.New(TWO_FRACTIONALS, DEFAULTS)
+	t.Eq(UDecimal(123450), dec.From.MParse("1'234.50",
+		ParseOptions{Grouping: '\''}))
+	t.Eq(UDecimal(123450), dec.From.MParse("1.234,50",
+		ParseOptions{Decimal: ',', Grouping: '.'}))
+	t.Eq(UDecimal(123456750), dec.From.MParse("12,34,567.50",
+		MLookupLocale("en-IN").ParseOptions()))
+	t.Eq(UDecimal(123456750), dec.From.MParse("1234,567.50",
+		ParseOptions{Grouping: ',', Primary: 3, Secondary: 4}))
+	t.Eq(UDecimal(123456750), dec.From.MParse("1\u202f234\u202f567,5",
+		MLookupLocale("fr-FR").ParseOptions()))
+}
+
+func (s *lenientParse) Accepts_surrounding_white_space(t *T) {
+	t.Eq(UDec.From.MStr("42.5"), UDec.From.MParse(" \t42.5\n",
+		ParseOptions{Space: true}))
+}
+
+func (s *lenientParse) Accepts_leading_plus(t *T) {
+	t.Eq(UDec.From.MStr("42.5"), UDec.From.MParse("+42.5",
+		ParseOptions{Plus: true}))
+}
+
+func (s *lenientParse) Accepts_currencies_before_or_after_number(t *T) {
+	dec := UDec.New(TWO_FRACTIONALS, DEFAULTS)
+	opts := ParseOptions{Grouping: '\'', Space: true, Plus: true,
+		Currencies: []string{"CHF", "€", "$"}}
+	for _, in := range []string{"CHF 1'234.5", "1'234.50 CHF",
+		"$1234.5", " 1234.50€ ", "€ +1'234.50", "CHF 1'234.50"} {
+		t.Eq(UDecimal(123450), dec.From.MParse(in, opts))
+	}
+}
+
+func (s *lenientParse) Accepts_scientific_notation(t *T) {
+	opts := ParseOptions{Exponent: true}
+	for in, exp := range map[string]string{
+		"1.5e3": "1500", "1.5E+3": "1500", "15e-1": "1.5",
+		"1234e-8": "0.000012", "0.000001e6": "1", "3e0": "3",
+		"1e-1000": "0", "0e1000": "0",
+	} {
+		t.Eq(UDec.From.MStr(exp), UDec.From.MParse(in, opts))
+	}
+	dec := UDec.New(TWO_FRACTIONALS|UP, DEFAULTS)
+	t.Eq(UDecimal(1), dec.From.MParse("1e-1000", opts))
+}
+
+func (s *lenientParse) Overflows_if_number_exceeds_max(t *T) {
+	_, err := UDec.From.Parse("1e20", ParseOptions{Exponent: true})
+	t.ErrIs(err, ErrOverflow)
+	_, err = UDec.From.Parse("1e1000", ParseOptions{Exponent: true})
+	t.ErrIs(err, ErrOverflow)
+	_, err = UDec.From.Parse("99999999999999999999999", ParseOptions{})
+	t.ErrIs(err, ErrOverflow)
+	t.Panics(func() {
+		UDec.From.MParse("1e20",
+			ParseOptions{Exponent: true})
+	})
+}
+
+func (s *lenientParse) Fails_precisely_for_not_enabled_input(t *T) {
+	for in, exp := range map[string]string{
+		" 42":      "unexpected white space at position 0",
+		"42 ":      "unexpected white space at position 2",
+		"+42":      `unexpected sign '\+' at position 0`,
+		"-42":      "unexpected sign '-' at position 0",
+		"1'234":    `unexpected '\\'' at position 1`,
+		"CHF 42":   `unexpected 'C' at position 0`,
+		"1.5e3":    `unexpected 'e' at position 3`,
+		"":         "missing digits at position 0",
+		".":        "missing digits at position 0",
+		"4 2":      "unexpected white space at position 1",
+		"1.2.3":    `second decimal separator '.' at position 3`,
+		"12a":      `unexpected 'a' at position 2`,
+		"١٢":       `unexpected '١' at position 0`,
+		"\u00a042": "unexpected white space at position 0",
+	} {
+		_, err := UDec.From.Parse(in, ParseOptions{})
+		t.ErrMatched(err, exp)
+		t.True(errors.Is(err, strconv.ErrSyntax))
+	}
+}
+
+func (s *lenientParse) Fails_for_misplaced_grouping(t *T) {
+	opts := ParseOptions{Grouping: ',', Exponent: true}
+	for in, exp := range map[string]string{
+		",123":         "grouping ',' not preceded by a digit at position 0",
+		"1,,234":       "grouping ',' not preceded by a digit at position 2",
+		"1,234,":       "trailing grouping ',' at position 5",
+		"1,.5":         "decimal separator '.' after grouping at position 2",
+		"1.2,5":        "grouping ',' in fractionals at position 3",
+		"1,e3":         "exponent after grouping at position 2",
+		"1e":           "missing exponent at position 2",
+		"1e+":          `invalid exponent "\+" at position 2`,
+		"1e3.5":        `invalid exponent "3.5" at position 2`,
+		"1e99999":      `exponent "99999" out of range at position 2`,
+		"e3":           "exponent without digits at position 0",
+		"1,2,3":        "grouping ',' after 1 digits at position 3",
+		"1,23":         "grouping ',' followed by 2 digits at position 1",
+		"1234,567":     "grouping ',' after 4 digits at position 4",
+		"12,3456.5":    "grouping ',' followed by 4 digits at position 2",
+		"1,234,5678e3": "grouping ',' followed by 4 digits at position 5",
+	} {
+		_, err := UDec.From.Parse(in, opts)
+		t.ErrMatched(err, exp)
+	}
+	_, err := UDec.From.Parse("1'2'3", ParseOptions{Grouping: '\''})
+	t.ErrMatched(err, `grouping '\\'' after 1 digits at position 3`)
+	_, err = UDec.From.Parse("12,345,678",
+		MLookupLocale("en-IN").ParseOptions())
+	t.ErrMatched(err, "grouping ',' after 3 digits at position 6")
+	_, err = UDec.From.Parse("1.5", ParseOptions{Grouping: '.'})
+	t.ErrMatched(err, "grouping equals decimal separator")
+}
+
+func (s *lenientParse) Reports_error_position_and_input(t *T) {
+	_, err := UDec.From.Parse("CHF 1x", ParseOptions{Space: true,
+		Currencies: []string{"CHF"}})
+	var pe *ParseError
+	t.True(errors.As(err, &pe))
+	t.Eq("CHF 1x", pe.Input)
+	t.Eq(5, pe.Pos)
+	t.Eq(`unexpected 'x'`, pe.Msg)
+}
+
+func (s *lenientParse) Applies_context_rounding_mode(t *T) {
+	dec := UDec.New(TWO_FRACTIONALS|HALF_UP, DEFAULTS)
+	t.Eq(UDecimal(123457), dec.From.MParse("1'234.565",
+		ParseOptions{Grouping: '\''}))
+	t.Eq(UDecimal(124), dec.From.MParse("12.35e-1",
+		ParseOptions{Exponent: true}))
+}
+
+func TestLenientParse(t *testing.T) {
+	t.Parallel()
+	Run(&lenientParse{}, t)
+}